@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/util/homedir"
+	"k8s.io/component-base/logs"
+	"k8s.io/kubectl/pkg/cmd"
+)
+
+var (
+	output       string
+	pkg          string
+	variableName string
+)
+
+func kubectlVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, m := range info.Deps {
+		if m.Path == "k8s.io/kubectl" {
+			return m.Version
+		}
+	}
+	return "unknown"
+}
+
+func writeOptions(buf *bytes.Buffer, flags *pflag.FlagSet) {
+	var options []*pflag.Flag
+	flags.VisitAll(func(f *pflag.Flag) {
+		options = append(options, f)
+	})
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Name < options[j].Name
+	})
+
+	fmt.Fprintln(buf, "options: []option{")
+	for _, f := range options {
+		defaultValue := f.DefValue
+		if home := homedir.HomeDir(); home != "" {
+			// e.g. '--cache-dir' should not depend on the environment generating this file.
+			defaultValue = replaceHome(defaultValue, home)
+		}
+		fmt.Fprintf(buf, "{name: %q", f.Name)
+		if f.Shorthand != "" {
+			fmt.Fprintf(buf, ", shorthand: %q", f.Shorthand)
+		}
+		fmt.Fprintf(buf, ", valueType: %q", f.Value.Type())
+		if defaultValue != "" {
+			fmt.Fprintf(buf, ", defaultValue: %q", defaultValue)
+		}
+		if f.NoOptDefVal != "" {
+			fmt.Fprintf(buf, ", noOptDefault: %q", f.NoOptDefVal)
+		}
+		if f.Deprecated != "" {
+			fmt.Fprintf(buf, ", deprecated: %q", f.Deprecated)
+		}
+		if f.Hidden {
+			fmt.Fprintf(buf, ", hidden: true")
+		}
+		fmt.Fprintf(buf, ", description: %q},\n", f.Usage)
+	}
+	fmt.Fprintln(buf, "},")
+}
+
+func replaceHome(s, home string) string {
+	return strings.ReplaceAll(s, home, "$HOME")
+}
+
+func writeCommand(buf *bytes.Buffer, c *cobra.Command, flags *pflag.FlagSet) {
+	fmt.Fprintf(buf, "name: %q,\n", c.Name())
+	if len(c.Aliases) > 0 {
+		fmt.Fprintf(buf, "aliases: %#v,\n", c.Aliases)
+	}
+	fmt.Fprintf(buf, "description: %q,\n", c.Short)
+	if c.Deprecated != "" {
+		fmt.Fprintf(buf, "deprecated: %q,\n", c.Deprecated)
+	}
+	writeOptions(buf, flags)
+
+	var subcommands []*cobra.Command
+	for _, sub := range c.Commands() {
+		if sub.Hidden {
+			continue
+		}
+		subcommands = append(subcommands, sub)
+	}
+	if len(subcommands) == 0 {
+		return
+	}
+	fmt.Fprintln(buf, "subcommands: []command{")
+	for _, sub := range subcommands {
+		fmt.Fprintln(buf, "{")
+		writeCommand(buf, sub, sub.NonInheritedFlags())
+		fmt.Fprintln(buf, "},")
+	}
+	fmt.Fprintln(buf, "},")
+}
+
+func generate() error {
+	root := cmd.NewDefaultKubectlCommand()
+	// kubectl adds the logging flags like '-v' in its main package.
+	logs.AddFlags(root.PersistentFlags())
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by 'command-gen' from k8s.io/kubectl %s. DO NOT EDIT.\n\n", kubectlVersion())
+	fmt.Fprintf(buf, "package %s\n\n", pkg)
+	// k8s.io/kubectl v0.30.x is released with kubectl v1.30.x.
+	fmt.Fprintf(buf, "// bundledKubectlVersion is the version of kubectl which %s is generated from.\n", variableName)
+	fmt.Fprintf(buf, "const bundledKubectlVersion = %q\n\n", strings.Replace(kubectlVersion(), "v0.", "v1.", 1))
+	fmt.Fprintf(buf, "var %s = command{\n", variableName)
+	// Persistent flags of the root command are the global flags shown by 'kubectl options'.
+	writeCommand(buf, root, root.PersistentFlags())
+	fmt.Fprintln(buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return ioutil.WriteFile(output, src, 0644)
+}
+
+func main() {
+	flag.StringVar(&output, "o", "", "output file. print stdout if empty")
+	flag.StringVar(&pkg, "pkg", "kube", "package name")
+	flag.StringVar(&variableName, "var", "kubectlCommand", "variable name")
+	flag.Parse()
+
+	if err := generate(); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
@@ -1,9 +1,12 @@
 package debug
 
 import (
+	"flag"
 	"io/ioutil"
 	"log"
 	"os"
+
+	"k8s.io/klog/v2"
 )
 
 const (
@@ -17,6 +20,7 @@ var (
 )
 
 func init() {
+	defer redirectKlog()
 	enableLog := os.Getenv(envEnableLog)
 	if enableLog == "true" || enableLog == "1" {
 		var err error
@@ -29,6 +33,21 @@ func init() {
 	logger = log.New(ioutil.Discard, "", log.Llongfile)
 }
 
+// redirectKlog prevents client-go from writing errors of informers to stderr,
+// which breaks the prompt.
+func redirectKlog() {
+	fs := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(fs)
+	_ = fs.Set("logtostderr", "false")
+	_ = fs.Set("alsologtostderr", "false")
+	_ = fs.Set("stderrthreshold", "FATAL")
+	if logfile == nil {
+		klog.SetOutput(ioutil.Discard)
+		return
+	}
+	klog.SetOutput(logfile)
+}
+
 // Teardown to close logfile
 func Teardown() {
 	if logfile == nil {
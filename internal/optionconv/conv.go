@@ -2,65 +2,150 @@ package optionconv
 
 import (
 	"errors"
+	"regexp"
+	"strconv"
 	"strings"
-
-	prompt "github.com/c-bata/go-prompt"
+	"time"
 )
 
-func GetOptionsFromHelpText(help string) (options string, err error) {
-	x := strings.Split(help, "\nOptions:")
-	if len(x) < 2 {
-		return "", errors.New("parse error")
-	}
-	y := strings.Split(x[1], "\n\n")
-	options = strings.Trim(y[0], "\n")
-	return options, nil
+// Flag is a flag of kubectl commands described in the help text.
+type Flag struct {
+	Name        string
+	Shorthand   string
+	Type        string
+	Default     string
+	Description string
+}
+
+// TakesValue returns false for boolean flags.
+func (f Flag) TakesValue() bool {
+	return f.Type != "bool"
 }
 
-func SplitOptions(options string) []string {
-	lines := strings.Split(options, "\n")
-	results := make([]string, 0, len(lines))
-	var index int
-	for _, l := range lines {
-		if strings.HasPrefix(l, "  ") || index == 0 {
-			results = append(results, strings.TrimSpace(l))
-			index++
-		} else {
-			results[index-1] += " " + l
+// GetFlagsFromHelpText parses the options of 'kubectl <subcmd> --help' and
+// 'kubectl options'. Both the legacy layout '  -f, --filename=[]: Filename...'
+// and the current layout '    -f, --filename=[]:\n\tFilename...' are supported.
+func GetFlagsFromHelpText(help string) ([]Flag, error) {
+	section := "\n" + help
+	if x := strings.SplitN(section, "\nOptions:\n", 2); len(x) == 2 {
+		section = x[1]
+	} else if x := strings.SplitN(section, "any command:\n", 2); len(x) == 2 {
+		section = x[1]
+	} else if strings.Contains(section, "\nUsage:") {
+		// The command has no flags except global ones.
+		return nil, nil
+	} else {
+		return nil, errors.New("parse error")
+	}
+	section = strings.SplitN(section, "\nUsage:", 2)[0]
+
+	var flags []Flag
+	for _, l := range strings.Split(section, "\n") {
+		if f, description, ok := parseFlagHeader(l); ok {
+			f.Description = description
+			flags = append(flags, f)
+			continue
 		}
+		if len(flags) == 0 || strings.TrimSpace(l) == "" {
+			continue
+		}
+		last := &flags[len(flags)-1]
+		if last.Description != "" {
+			last.Description += " "
+		}
+		last.Description += strings.TrimSpace(l)
+	}
+	if len(flags) == 0 {
+		return nil, errors.New("parse error")
 	}
-	return results
+	return flags, nil
 }
 
-func convertToSuggest(flagLine string) []prompt.Suggest {
-	x := strings.SplitN(flagLine, ": ", 2)
-	key := x[0]
-	description := x[1]
-
-	var keys []string
-	if strings.Contains(key, ", ") {
-		keys = strings.Split(key, ", ")
-	} else {
-		keys = []string{key}
+// parseFlagHeader parses '  -f, --filename=[]: description' or '    -f, --filename=[]:'.
+func parseFlagHeader(line string) (f Flag, description string, ok bool) {
+	if !strings.HasPrefix(line, " ") {
+		return f, "", false
 	}
-	suggests := make([]prompt.Suggest, len(keys))
-	for i := range keys {
-		if strings.Contains(keys[i], "=") {
-			keys[i] = strings.Split(keys[i], "=")[0]
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "-") && !strings.HasPrefix(line, "--") {
+		// shorthand like '-f, '
+		if len(line) < 4 || line[2:4] != ", " {
+			return f, "", false
 		}
-		keys[i] = strings.TrimSpace(keys[i])
-		suggests[i] = prompt.Suggest{Text: keys[i], Description: description}
+		f.Shorthand = line[1:2]
+		line = line[4:]
+	}
+	if !strings.HasPrefix(line, "--") {
+		return f, "", false
 	}
-	return suggests
+	eq := strings.Index(line, "=")
+	if eq < 0 {
+		return f, "", false
+	}
+	f.Name = line[2:eq]
+	rest := line[eq+1:]
+	if i := strings.Index(rest, ": "); i >= 0 {
+		f.Default, description = rest[:i], strings.TrimSpace(rest[i+2:])
+	} else if strings.HasSuffix(rest, ":") {
+		f.Default = strings.TrimSuffix(rest, ":")
+	} else {
+		return f, "", false
+	}
+	f.Type, f.Default = flagType(f.Default)
+	return f, description, true
+}
+
+// flagType guesses the type from the default value printed by kubectl.
+func flagType(defaultValue string) (typ string, value string) {
+	switch {
+	case defaultValue == "true" || defaultValue == "false":
+		return "bool", defaultValue
+	case strings.HasPrefix(defaultValue, "'") && strings.HasSuffix(defaultValue, "'") && len(defaultValue) >= 2:
+		return "string", defaultValue[1 : len(defaultValue)-1]
+	case strings.HasPrefix(defaultValue, "["):
+		return "stringSlice", defaultValue
+	case strings.HasPrefix(defaultValue, "map["):
+		return "stringToString", defaultValue
+	}
+	if _, err := strconv.Atoi(defaultValue); err == nil {
+		return "int", defaultValue
+	}
+	if _, err := strconv.ParseFloat(defaultValue, 64); err == nil {
+		return "float64", defaultValue
+	}
+	if _, err := time.ParseDuration(defaultValue); err == nil {
+		return "duration", defaultValue
+	}
+	return "string", defaultValue
 }
 
-func ConvertToSuggestions(options []string) []prompt.Suggest {
-	suggestions := make([]prompt.Suggest, 0, len(options))
-	for i := range options {
-		x := convertToSuggest(options[i])
-		for j := range x {
-			suggestions = append(suggestions, x[j])
+var (
+	// e.g. 'One of: (json, yaml, name)', 'One of (none|cpu|heap)' or 'Must be one of [status scale]'
+	enumListPattern = regexp.MustCompile(`(?i)one of:?\s*[(\[]([^)\]]+)[)\]]`)
+	// e.g. 'Must be "none", "server", or "client"' or "One of 'yaml' or 'json'"
+	enumQuotedPattern = regexp.MustCompile(`(?i)(?:one of|must be):?\s*((?:['"][\w-]+['"],?\s*(?:or\s+)?)+)`)
+	// e.g. 'One of: json|yaml|wide|name|custom-columns=...' in the legacy help
+	enumPipePattern = regexp.MustCompile(`(?i)one of:\s*([\w-]+(?:=\.\.\.)?(?:\|[\w-]+(?:=\.\.\.)?)+)`)
+	quotedPattern   = regexp.MustCompile(`['"]([\w-]+)['"]`)
+)
+
+// EnumValues returns the values listed in the description of a flag like
+// "Output format. One of: (json, yaml, name)."
+func EnumValues(description string) []string {
+	var values []string
+	if m := enumListPattern.FindStringSubmatch(description); m != nil {
+		values = strings.FieldsFunc(m[1], func(r rune) bool {
+			return r == ',' || r == '|' || r == ' '
+		})
+	} else if m := enumQuotedPattern.FindStringSubmatch(description); m != nil {
+		for _, q := range quotedPattern.FindAllStringSubmatch(m[1], -1) {
+			values = append(values, q[1])
 		}
+	} else if m := enumPipePattern.FindStringSubmatch(description); m != nil {
+		values = strings.Split(m[1], "|")
+	}
+	for i := range values {
+		values[i] = strings.TrimSuffix(values[i], "...")
 	}
-	return suggestions
+	return values
 }
@@ -2,395 +2,144 @@ package kube
 
 import (
 	"context"
+	"strings"
 
 	"github.com/c-bata/go-prompt"
 )
 
-var commands = []prompt.Suggest{
-	{Text: "get", Description: "Display one or many resources"},
-	{Text: "describe", Description: "Show details of a specific resource or group of resources"},
-	{Text: "create", Description: "Create a resource by filename or stdin"},
-	{Text: "replace", Description: "Replace a resource by filename or stdin."},
-	{Text: "patch", Description: "Update field(s) of a resource using strategic merge patch."},
-	{Text: "delete", Description: "Delete resources by filenames, stdin, resources and names, or by resources and label selector."},
-	{Text: "edit", Description: "Edit a resource on the server"},
-	{Text: "apply", Description: "Apply a configuration to a resource by filename or stdin"},
-	{Text: "namespace", Description: "SUPERSEDED: Set and view the current Kubernetes namespace"},
-	{Text: "logs", Description: "Print the logs for a container in a pod."},
-	{Text: "rolling-update", Description: "Perform a rolling update of the given ReplicationController."},
-	{Text: "scale", Description: "Set a new size for a Deployment, ReplicaSet, Replication Controller, or Job."},
-	{Text: "cordon", Description: "Mark node as unschedulable"},
-	{Text: "drain", Description: "Drain node in preparation for maintenance"},
-	{Text: "uncordon", Description: "Mark node as schedulable"},
-	{Text: "attach", Description: "Attach to a running container."},
-	{Text: "exec", Description: "Execute a command in a container."},
-	{Text: "port-forward", Description: "Forward one or more local ports to a pod."},
-	{Text: "proxy", Description: "Run a proxy to the Kubernetes API server"},
-	{Text: "run", Description: "Run a particular image on the cluster."},
-	{Text: "expose", Description: "Take a replication controller, service, or pod and expose it as a new Kubernetes Service"},
-	{Text: "autoscale", Description: "Auto-scale a Deployment, ReplicaSet, or ReplicationController"},
-	{Text: "rollout", Description: "rollout manages a deployment"},
-	{Text: "label", Description: "Update the labels on a resource"},
-	{Text: "annotate", Description: "Update the annotations on a resource"},
-	{Text: "config", Description: "config modifies kubeconfig files"},
-	{Text: "cluster-info", Description: "Display cluster info"},
-	{Text: "api-versions", Description: "Print the supported API versions on the server, in the form of 'group/version'."},
-	{Text: "version", Description: "Print the client and server version information."},
-	{Text: "explain", Description: "Documentation of resources."},
-	{Text: "convert", Description: "Convert config files between different API versions"},
-	{Text: "top", Description: "Display Resource (CPU/Memory/Storage) usage"},
-
-	// Custom command.
+// commands are the commands of kubectl and the ones built in kube-prompt.
+var commands = append(commandSuggestions(&kubectlCommand), []prompt.Suggest{
+	{Text: "ns", Description: "Set the namespace of this session. 'ns -' switches back to the previous one"},
 	{Text: "exit", Description: "Exit this program"},
-}
+}...)
 
+// resourceTypes is used until the discovery of the API server is finished.
 var resourceTypes = []prompt.Suggest{
-	{Text: "clusters"}, // valid only for federation apiservers
+	{Text: "certificatesigningrequests"},
+	{Text: "clusterrolebindings"},
+	{Text: "clusterroles"},
 	{Text: "componentstatuses"},
 	{Text: "configmaps"},
+	{Text: "controllerrevisions"},
+	{Text: "cronjobs"},
+	{Text: "csidrivers"},
+	{Text: "csinodes"},
+	{Text: "csistoragecapacities"},
 	{Text: "daemonsets"},
 	{Text: "deployments"},
 	{Text: "endpoints"},
+	{Text: "endpointslices"},
 	{Text: "events"},
 	{Text: "horizontalpodautoscalers"},
+	{Text: "ingressclasses"},
 	{Text: "ingresses"},
 	{Text: "jobs"},
-	{Text: "cronjobs"},
+	{Text: "leases"},
 	{Text: "limitranges"},
+	{Text: "mutatingwebhookconfigurations"},
 	{Text: "namespaces"},
 	{Text: "networkpolicies"},
 	{Text: "nodes"},
 	{Text: "persistentvolumeclaims"},
 	{Text: "persistentvolumes"},
-	{Text: "pod"},
-	{Text: "podsecuritypolicies"},
+	{Text: "poddisruptionbudgets"},
+	{Text: "pods"},
 	{Text: "podtemplates"},
+	{Text: "priorityclasses"},
 	{Text: "replicasets"},
 	{Text: "replicationcontrollers"},
 	{Text: "resourcequotas"},
+	{Text: "rolebindings"},
+	{Text: "roles"},
 	{Text: "secrets"},
 	{Text: "serviceaccounts"},
 	{Text: "services"},
 	{Text: "statefulsets"},
 	{Text: "storageclasses"},
-	{Text: "thirdpartyresources"},
+	{Text: "validatingadmissionpolicies"},
+	{Text: "validatingadmissionpolicybindings"},
+	{Text: "validatingwebhookconfigurations"},
+	{Text: "volumeattachments"},
 
 	// aliases
-	{Text: "cs"},
+	{Text: "cj"},
 	{Text: "cm"},
-	{Text: "ds"},
+	{Text: "cs"},
+	{Text: "csr"},
 	{Text: "deploy"},
+	{Text: "ds"},
 	{Text: "ep"},
 	{Text: "hpa"},
 	{Text: "ing"},
 	{Text: "limits"},
-	{Text: "ns"},
+	{Text: "netpol"},
 	{Text: "no"},
-	{Text: "pvc"},
-	{Text: "pv"},
+	{Text: "ns"},
+	{Text: "pc"},
+	{Text: "pdb"},
 	{Text: "po"},
-	{Text: "psp"},
-	{Text: "rs"},
-	{Text: "rc"},
+	{Text: "pv"},
+	{Text: "pvc"},
 	{Text: "quota"},
+	{Text: "rc"},
+	{Text: "rs"},
 	{Text: "sa"},
+	{Text: "sc"},
+	{Text: "sts"},
 	{Text: "svc"},
 }
 
 func (c *Completer) argumentsCompleter(ctx context.Context, namespace string, args []string) []prompt.Suggest {
 	if len(args) <= 1 {
-		return prompt.FilterHasPrefix(commands, args[0], true)
+		return prompt.FilterHasPrefix(c.filterReadOnly("", commands), args[0], true)
+	}
+	if path, rest := lookupCommand(args[:len(args)-1]); len(rest) == 0 && len(path[len(path)-1].subcommands) > 0 {
+		// e.g. 'rollout ' or 'create secret '
+		suggests := c.filterReadOnly(commandName(path), commandSuggestions(path[len(path)-1]))
+		return prompt.FilterHasPrefix(suggests, args[len(args)-1], true)
+	}
+
+	if suggests, ok := c.typedArgumentSuggestions(ctx, namespace, args[len(args)-1]); ok {
+		return excludeTyped(suggests, args[1:len(args)-1])
 	}
 
 	first := args[0]
 	switch first {
-	case "get":
+	case "get", "describe", "delete", "edit":
 		second := args[1]
 		if len(args) == 2 {
-			subcommands := []prompt.Suggest{
-				{Text: "componentstatuses"},
-				{Text: "configmaps"},
-				{Text: "daemonsets"},
-				{Text: "deployments"},
-				{Text: "endpoints"},
-				{Text: "events"},
-				{Text: "horizontalpodautoscalers"},
-				{Text: "ingresses"},
-				{Text: "jobs"},
-				{Text: "cronjobs"},
-				{Text: "limitranges"},
-				{Text: "namespaces"},
-				{Text: "networkpolicies"},
-				{Text: "nodes"},
-				{Text: "persistentvolumeclaims"},
-				{Text: "persistentvolumes"},
-				{Text: "pod"},
-				{Text: "podsecuritypolicies"},
-				{Text: "podtemplates"},
-				{Text: "replicasets"},
-				{Text: "replicationcontrollers"},
-				{Text: "resourcequotas"},
-				{Text: "secrets"},
-				{Text: "serviceaccounts"},
-				{Text: "services"},
-				{Text: "statefulsets"},
-				{Text: "storageclasses"},
-				{Text: "thirdpartyresources"},
-				// aliases
-				{Text: "cs"},
-				{Text: "cm"},
-				{Text: "ds"},
-				{Text: "deploy"},
-				{Text: "ep"},
-				{Text: "hpa"},
-				{Text: "ing"},
-				{Text: "limits"},
-				{Text: "ns"},
-				{Text: "no"},
-				{Text: "pvc"},
-				{Text: "pv"},
-				{Text: "po"},
-				{Text: "psp"},
-				{Text: "rs"},
-				{Text: "rc"},
-				{Text: "quota"},
-				{Text: "sa"},
-				{Text: "svc"},
-			}
-			return prompt.FilterHasPrefix(subcommands, second, true)
+			return prompt.FilterHasPrefix(getResourceTypeSuggestions(c.discoveryClient(), c.context), second, true)
 		}
 
-		third := args[2]
-		if len(args) == 3 {
-			switch second {
-			case "componentstatuses", "cs":
-				return prompt.FilterContains(getComponentStatusCompletions(ctx, c.client), third, true)
-			case "configmaps", "cm":
-				return prompt.FilterContains(getConfigMapSuggestions(ctx, c.client, namespace), third, true)
-			case "daemonsets", "ds":
-				return prompt.FilterContains(getDaemonSetSuggestions(ctx, c.client, namespace), third, true)
-			case "deploy", "deployments":
-				return prompt.FilterContains(getDeploymentSuggestions(ctx, c.client, namespace), third, true)
-			case "endpoints", "ep":
-				return prompt.FilterContains(getEndpointsSuggestions(ctx, c.client, namespace), third, true)
-			case "ingresses", "ing":
-				return prompt.FilterContains(getIngressSuggestions(ctx, c.client, namespace), third, true)
-			case "limitranges", "limits":
-				return prompt.FilterContains(getLimitRangeSuggestions(ctx, c.client, namespace), third, true)
-			case "namespaces", "ns":
-				return prompt.FilterContains(getNameSpaceSuggestions(c.namespaceList), third, true)
-			case "no", "nodes":
-				return prompt.FilterContains(getNodeSuggestions(ctx, c.client), third, true)
-			case "po", "pod", "pods":
-				return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumeclaims", "pvc":
-				return prompt.FilterContains(getPersistentVolumeClaimSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumes", "pv":
-				return prompt.FilterContains(getPersistentVolumeSuggestions(ctx, c.client), third, true)
-			case "podtemplates":
-				return prompt.FilterContains(getPodTemplateSuggestions(ctx, c.client, namespace), third, true)
-			case "replicasets", "rs":
-				return prompt.FilterContains(getReplicaSetSuggestions(ctx, c.client, namespace), third, true)
-			case "replicationcontrollers", "rc":
-				return prompt.FilterContains(getReplicationControllerSuggestions(ctx, c.client, namespace), third, true)
-			case "resourcequotas", "quota":
-				return prompt.FilterContains(getResourceQuotasSuggestions(ctx, c.client, namespace), third, true)
-			case "secrets":
-				return prompt.FilterContains(getSecretSuggestions(ctx, c.client, namespace), third, true)
-			case "sa", "serviceaccounts":
-				return prompt.FilterContains(getServiceAccountSuggestions(ctx, c.client, namespace), third, true)
-			case "svc", "services":
-				return prompt.FilterContains(getServiceSuggestions(ctx, c.client, namespace), third, true)
-			case "job", "jobs":
-				return prompt.FilterContains(getJobSuggestions(ctx, c.client, namespace), third, true)
-			}
-		}
-	case "describe":
-		second := args[1]
-		if len(args) == 2 {
-			return prompt.FilterHasPrefix(resourceTypes, second, true)
-		}
+		// e.g. 'delete cm a b '
+		suggests := excludeTyped(c.getResourceNameSuggestions(ctx, namespace, second), args[2:len(args)-1])
+		return prompt.FilterContains(suggests, args[len(args)-1], true)
 
-		third := args[2]
-		if len(args) == 3 {
-			switch second {
-			case "componentstatuses", "cs":
-				return prompt.FilterContains(getComponentStatusCompletions(ctx, c.client), third, true)
-			case "configmaps", "cm":
-				return prompt.FilterContains(getConfigMapSuggestions(ctx, c.client, namespace), third, true)
-			case "daemonsets", "ds":
-				return prompt.FilterContains(getDaemonSetSuggestions(ctx, c.client, namespace), third, true)
-			case "deploy", "deployments":
-				return prompt.FilterContains(getDeploymentSuggestions(ctx, c.client, namespace), third, true)
-			case "endpoints", "ep":
-				return prompt.FilterContains(getEndpointsSuggestions(ctx, c.client, namespace), third, true)
-			case "ingresses", "ing":
-				return prompt.FilterContains(getIngressSuggestions(ctx, c.client, namespace), third, true)
-			case "limitranges", "limits":
-				return prompt.FilterContains(getLimitRangeSuggestions(ctx, c.client, namespace), third, true)
-			case "namespaces", "ns":
-				return prompt.FilterContains(getNameSpaceSuggestions(c.namespaceList), third, true)
-			case "no", "nodes":
-				return prompt.FilterContains(getNodeSuggestions(ctx, c.client), third, true)
-			case "po", "pod", "pods":
-				return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumeclaims", "pvc":
-				return prompt.FilterContains(getPersistentVolumeClaimSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumes", "pv":
-				return prompt.FilterContains(getPersistentVolumeSuggestions(ctx, c.client), third, true)
-			case "podtemplates":
-				return prompt.FilterContains(getPodTemplateSuggestions(ctx, c.client, namespace), third, true)
-			case "replicasets", "rs":
-				return prompt.FilterContains(getReplicaSetSuggestions(ctx, c.client, namespace), third, true)
-			case "replicationcontrollers", "rc":
-				return prompt.FilterContains(getReplicationControllerSuggestions(ctx, c.client, namespace), third, true)
-			case "resourcequotas", "quota":
-				return prompt.FilterContains(getResourceQuotasSuggestions(ctx, c.client, namespace), third, true)
-			case "secrets":
-				return prompt.FilterContains(getSecretSuggestions(ctx, c.client, namespace), third, true)
-			case "sa", "serviceaccounts":
-				return prompt.FilterContains(getServiceAccountSuggestions(ctx, c.client, namespace), third, true)
-			case "svc", "services":
-				return prompt.FilterContains(getServiceSuggestions(ctx, c.client, namespace), third, true)
-			case "job", "jobs":
-				return prompt.FilterContains(getJobSuggestions(ctx, c.client, namespace), third, true)
-			}
-		}
-	case "create":
-		subcommands := []prompt.Suggest{
-			{Text: "configmap", Description: "Create a configmap from a local file, directory or literal value"},
-			{Text: "deployment", Description: "Create a deployment with the specified name."},
-			{Text: "namespace", Description: "Create a namespace with the specified name"},
-			{Text: "quota", Description: "Create a quota with the specified name."},
-			{Text: "secret", Description: "Create a secret using specified subcommand"},
-			{Text: "service", Description: "Create a service using specified subcommand."},
-			{Text: "serviceaccount", Description: "Create a service account with the specified name"},
-		}
+	case "ns":
 		if len(args) == 2 {
-			return prompt.FilterHasPrefix(subcommands, args[1], true)
-		}
-	case "delete":
-		second := args[1]
-		if len(args) == 2 {
-			return prompt.FilterHasPrefix(resourceTypes, second, true)
-		}
-
-		third := args[2]
-		if len(args) == 3 {
-			switch second {
-			case "componentstatuses", "cs":
-				return prompt.FilterContains(getComponentStatusCompletions(ctx, c.client), third, true)
-			case "configmaps", "cm":
-				return prompt.FilterContains(getConfigMapSuggestions(ctx, c.client, namespace), third, true)
-			case "daemonsets", "ds":
-				return prompt.FilterContains(getDaemonSetSuggestions(ctx, c.client, namespace), third, true)
-			case "deploy", "deployments":
-				return prompt.FilterContains(getDeploymentSuggestions(ctx, c.client, namespace), third, true)
-			case "endpoints", "ep":
-				return prompt.FilterContains(getEndpointsSuggestions(ctx, c.client, namespace), third, true)
-			case "ingresses", "ing":
-				return prompt.FilterContains(getIngressSuggestions(ctx, c.client, namespace), third, true)
-			case "limitranges", "limits":
-				return prompt.FilterContains(getLimitRangeSuggestions(ctx, c.client, namespace), third, true)
-			case "namespaces", "ns":
-				return prompt.FilterContains(getNameSpaceSuggestions(c.namespaceList), third, true)
-			case "no", "nodes":
-				return prompt.FilterContains(getNodeSuggestions(ctx, c.client), third, true)
-			case "po", "pod", "pods":
-				return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumeclaims", "pvc":
-				return prompt.FilterContains(getPersistentVolumeClaimSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumes", "pv":
-				return prompt.FilterContains(getPersistentVolumeSuggestions(ctx, c.client), third, true)
-			case "podtemplates":
-				return prompt.FilterContains(getPodTemplateSuggestions(ctx, c.client, namespace), third, true)
-			case "replicasets", "rs":
-				return prompt.FilterContains(getReplicaSetSuggestions(ctx, c.client, namespace), third, true)
-			case "replicationcontrollers", "rc":
-				return prompt.FilterContains(getReplicationControllerSuggestions(ctx, c.client, namespace), third, true)
-			case "resourcequotas", "quota":
-				return prompt.FilterContains(getResourceQuotasSuggestions(ctx, c.client, namespace), third, true)
-			case "secrets":
-				return prompt.FilterContains(getSecretSuggestions(ctx, c.client, namespace), third, true)
-			case "sa", "serviceaccounts":
-				return prompt.FilterContains(getServiceAccountSuggestions(ctx, c.client, namespace), third, true)
-			case "svc", "services":
-				return prompt.FilterContains(getServiceSuggestions(ctx, c.client, namespace), third, true)
-			case "job", "jobs":
-				return prompt.FilterContains(getJobSuggestions(ctx, c.client, namespace), third, true)
+			suggests := getNameSpaceSuggestions(c.namespaceList)
+			if prev := c.previousNamespace(); prev != "" {
+				suggests = append(suggests, prompt.Suggest{Text: "-", Description: "Switch back to " + prev})
 			}
-		}
-	case "edit":
-		if len(args) == 2 {
-			return prompt.FilterHasPrefix(resourceTypes, args[1], true)
-		}
-
-		if len(args) == 3 {
-			third := args[2]
-			switch args[1] {
-			case "componentstatuses", "cs":
-				return prompt.FilterContains(getComponentStatusCompletions(ctx, c.client), third, true)
-			case "configmaps", "cm":
-				return prompt.FilterContains(getConfigMapSuggestions(ctx, c.client, namespace), third, true)
-			case "daemonsets", "ds":
-				return prompt.FilterContains(getDaemonSetSuggestions(ctx, c.client, namespace), third, true)
-			case "deploy", "deployments":
-				return prompt.FilterContains(getDeploymentSuggestions(ctx, c.client, namespace), third, true)
-			case "endpoints", "ep":
-				return prompt.FilterContains(getEndpointsSuggestions(ctx, c.client, namespace), third, true)
-			case "ingresses", "ing":
-				return prompt.FilterContains(getIngressSuggestions(ctx, c.client, namespace), third, true)
-			case "limitranges", "limits":
-				return prompt.FilterContains(getLimitRangeSuggestions(ctx, c.client, namespace), third, true)
-			case "namespaces", "ns":
-				return prompt.FilterContains(getNameSpaceSuggestions(c.namespaceList), third, true)
-			case "no", "nodes":
-				return prompt.FilterContains(getNodeSuggestions(ctx, c.client), third, true)
-			case "po", "pod", "pods":
-				return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumeclaims", "pvc":
-				return prompt.FilterContains(getPersistentVolumeClaimSuggestions(ctx, c.client, namespace), third, true)
-			case "persistentvolumes", "pv":
-				return prompt.FilterContains(getPersistentVolumeSuggestions(ctx, c.client), third, true)
-			case "podtemplates":
-				return prompt.FilterContains(getPodTemplateSuggestions(ctx, c.client, namespace), third, true)
-			case "replicasets", "rs":
-				return prompt.FilterContains(getReplicaSetSuggestions(ctx, c.client, namespace), third, true)
-			case "replicationcontrollers", "rc":
-				return prompt.FilterContains(getReplicationControllerSuggestions(ctx, c.client, namespace), third, true)
-			case "resourcequotas", "quota":
-				return prompt.FilterContains(getResourceQuotasSuggestions(ctx, c.client, namespace), third, true)
-			case "secrets":
-				return prompt.FilterContains(getSecretSuggestions(ctx, c.client, namespace), third, true)
-			case "sa", "serviceaccounts":
-				return prompt.FilterContains(getServiceAccountSuggestions(ctx, c.client, namespace), third, true)
-			case "svc", "services":
-				return prompt.FilterContains(getServiceSuggestions(ctx, c.client, namespace), third, true)
-			case "job", "jobs":
-				return prompt.FilterContains(getJobSuggestions(ctx, c.client, namespace), third, true)
-			}
-		}
-
-	case "namespace":
-		if len(args) == 2 {
-			return prompt.FilterContains(getNameSpaceSuggestions(c.namespaceList), args[1], true)
+			return prompt.FilterContains(suggests, args[1], true)
 		}
 	case "logs":
 		if len(args) == 2 {
-			return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), args[1], true)
+			return prompt.FilterContains(c.getObjectSuggestions(podResource, namespace), args[1], true)
 		}
 	case "rolling-update", "rollingupdate":
 		if len(args) == 2 {
-			return prompt.FilterContains(getReplicationControllerSuggestions(ctx, c.client, namespace), args[1], true)
+			return prompt.FilterContains(c.getObjectSuggestions(replicationControllerResource, namespace), args[1], true)
 		} else if len(args) == 3 {
-			return prompt.FilterContains(getReplicationControllerSuggestions(ctx, c.client, namespace), args[2], true)
+			return prompt.FilterContains(c.getObjectSuggestions(replicationControllerResource, namespace), args[2], true)
 		}
 	case "scale", "resize":
 		if len(args) == 2 {
-			// Deployment, ReplicaSet, Replication Controller, or Job.
-			r := getDeploymentSuggestions(ctx, c.client, namespace)
-			r = append(r, getReplicaSetSuggestions(ctx, c.client, namespace)...)
-			r = append(r, getReplicationControllerSuggestions(ctx, c.client, namespace)...)
+			// Deployment, ReplicaSet, Replication Controller, or StatefulSet.
+			r := c.getObjectSuggestions(deploymentResource, namespace)
+			r = append(r, c.getObjectSuggestions(replicaSetResource, namespace)...)
+			r = append(r, c.getObjectSuggestions(replicationControllerResource, namespace)...)
+			r = append(r, c.getObjectSuggestions(statefulSetResource, namespace)...)
 			return prompt.FilterContains(r, args[1], true)
 		}
 	case "cordon":
@@ -399,88 +148,48 @@ func (c *Completer) argumentsCompleter(ctx context.Context, namespace string, ar
 		fallthrough
 	case "uncordon":
 		if len(args) == 2 {
-			return prompt.FilterHasPrefix(getNodeSuggestions(ctx, c.client), args[1], true)
+			return prompt.FilterHasPrefix(c.getObjectSuggestions(nodeResource, namespace), args[1], true)
 		}
 	case "attach":
 		if len(args) == 2 {
-			return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), args[1], true)
+			return prompt.FilterContains(c.getObjectSuggestions(podResource, namespace), args[1], true)
 		}
 	case "exec":
 		if len(args) == 2 {
-			return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), args[1], true)
+			return prompt.FilterContains(c.getObjectSuggestions(podResource, namespace), args[1], true)
 		}
 	case "port-forward":
 		if len(args) == 2 {
-			return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), args[1], true)
+			return prompt.FilterContains(c.getObjectSuggestions(podResource, namespace), args[1], true)
 		}
 		if len(args) == 3 {
-			return prompt.FilterHasPrefix(getPortsFromPodName(namespace, args[1]), args[2], true)
-		}
-	case "rollout":
-		subCommands := []prompt.Suggest{
-			{Text: "history", Description: "view rollout history"},
-			{Text: "pause", Description: "Mark the provided resource as paused"},
-			{Text: "resume", Description: "Resume a paused resource"},
-			{Text: "undo", Description: "undoes a previous rollout"},
-		}
-		if len(args) == 2 {
-			return prompt.FilterHasPrefix(subCommands, args[1], true)
+			return prompt.FilterHasPrefix(c.getPortsFromPodName(namespace, args[1]), args[2], true)
 		}
 	case "annotate":
 	case "config":
-		subCommands := []prompt.Suggest{
-			{Text: "current-context", Description: "Displays the current-context"},
-			{Text: "delete-cluster", Description: "Delete the specified cluster from the kubeconfig"},
-			{Text: "delete-context", Description: "Delete the specified context from the kubeconfig"},
-			{Text: "get-clusters", Description: "Display clusters defined in the kubeconfig"},
-			{Text: "get-contexts", Description: "Describe one or many contexts"},
-			{Text: "set", Description: "Sets an individual value in a kubeconfig file"},
-			{Text: "set-cluster", Description: "Sets a cluster entry in kubeconfig"},
-			{Text: "set-context", Description: "Sets a context entry in kubeconfig"},
-			{Text: "set-credentials", Description: "Sets a user entry in kubeconfig"},
-			{Text: "unset", Description: "Unsets an individual value in a kubeconfig file"},
-			{Text: "use-context", Description: "Sets the current-context in a kubeconfig file"},
-			{Text: "view", Description: "Display merged kubeconfig settings or a specified kubeconfig file"},
-		}
-		if len(args) == 2 {
-			return prompt.FilterHasPrefix(subCommands, args[1], true)
-		}
 		if len(args) == 3 {
 			third := args[2]
 			switch args[1] {
 			case "use-context":
-				return prompt.FilterContains(getContextSuggestions(), third, true)
+				return prompt.FilterContains(c.getKubeconfigSuggestions("context"), third, true)
 			}
 		}
-	case "cluster-info":
-		subCommands := []prompt.Suggest{
-			{Text: "dump", Description: "Dump lots of relevant info for debugging and diagnosis"},
-		}
-		if len(args) == 2 {
-			return prompt.FilterHasPrefix(subCommands, args[1], true)
-		}
 	case "explain":
-		return prompt.FilterHasPrefix(resourceTypes, args[1], true)
-	case "top":
-		second := args[1]
 		if len(args) == 2 {
-			subcommands := []prompt.Suggest{
-				{Text: "nodes"},
-				{Text: "pod"},
-				// aliases
-				{Text: "no"},
-				{Text: "po"},
+			if strings.Contains(args[1], ".") {
+				// e.g. 'explain deployment.spec.template.spec.con'
+				return prompt.FilterHasPrefix(c.getExplainSuggestions(args[1]), args[1], true)
 			}
-			return prompt.FilterHasPrefix(subcommands, second, true)
+			return prompt.FilterHasPrefix(getResourceTypeSuggestions(c.discoveryClient(), c.context), args[1], true)
 		}
-
-		third := args[2]
+	case "top":
 		if len(args) == 3 {
+			second, third := args[1], args[2]
 			switch second {
 			case "no", "node", "nodes":
-				return prompt.FilterContains(getNodeSuggestions(ctx, c.client), third, true)
+				return prompt.FilterContains(c.getObjectSuggestions(nodeResource, namespace), third, true)
 			case "po", "pod", "pods":
-				return prompt.FilterContains(getPodSuggestions(ctx, c.client, namespace), third, true)
+				return prompt.FilterContains(c.getObjectSuggestions(podResource, namespace), third, true)
 			}
 		}
 	default:
@@ -488,3 +197,80 @@ func (c *Completer) argumentsCompleter(ctx context.Context, namespace string, ar
 	}
 	return []prompt.Suggest{}
 }
+
+// getResourceNameSuggestions returns the names of objects for the given resource type
+// like 'pods', 'po' or 'certificates.cert-manager.io'.
+func (c *Completer) getResourceNameSuggestions(ctx context.Context, namespace string, resourceType string) []prompt.Suggest {
+	switch resourceType {
+	case "namespaces", "namespace", "ns":
+		return getNameSpaceSuggestions(c.namespaceList)
+	}
+	if r, ok := lookupResourceDescriptor(resourceType); ok {
+		return c.getObjectSuggestions(r, namespace)
+	}
+
+	// Any other resource types like CustomResources are resolved via discovery.
+	r, ok := lookupAPIResource(c.discoveryClient(), c.context, resourceType)
+	if !ok {
+		return []prompt.Suggest{}
+	}
+	return c.getObjectMetadataSuggestions(r, namespace)
+}
+
+// typedArgumentSuggestions completes the argument in any position like
+// 'deploy/we' with the names of the type, or 'pods,sv' with the next
+// resource type. It returns false if the argument is not the form.
+func (c *Completer) typedArgumentSuggestions(ctx context.Context, namespace string, arg string) ([]prompt.Suggest, bool) {
+	if resourceType, name, ok := strings.Cut(arg, "/"); ok {
+		if _, found := c.isNamespacedResource(resourceType); !found {
+			// e.g. 'exec web -- ls /tmp'
+			return nil, false
+		}
+		suggests := prompt.FilterContains(c.getResourceNameSuggestions(ctx, namespace, resourceType), name, true)
+		result := make([]prompt.Suggest, len(suggests))
+		for i := range suggests {
+			result[i] = prompt.Suggest{Text: resourceType + "/" + suggests[i].Text, Description: suggests[i].Description}
+		}
+		return result, true
+	}
+
+	i := strings.LastIndexByte(arg, ',')
+	if i < 0 {
+		return nil, false
+	}
+	typed := make(map[string]struct{})
+	for _, t := range strings.Split(arg[:i], ",") {
+		if _, found := c.isNamespacedResource(t); !found {
+			return nil, false
+		}
+		typed[t] = struct{}{}
+	}
+	suggests := prompt.FilterHasPrefix(getResourceTypeSuggestions(c.discoveryClient(), c.context), arg[i+1:], true)
+	result := make([]prompt.Suggest, 0, len(suggests))
+	for _, s := range suggests {
+		if _, ok := typed[s.Text]; ok {
+			continue
+		}
+		result = append(result, prompt.Suggest{Text: arg[:i+1] + s.Text, Description: s.Description})
+	}
+	return result, true
+}
+
+// excludeTyped drops the suggestions already typed in the arguments,
+// so that several objects can be selected quickly like 'delete cm a b '.
+func excludeTyped(suggests []prompt.Suggest, typed []string) []prompt.Suggest {
+	if len(typed) == 0 {
+		return suggests
+	}
+	seen := make(map[string]struct{}, len(typed))
+	for _, t := range typed {
+		seen[t] = struct{}{}
+	}
+	result := make([]prompt.Suggest, 0, len(suggests))
+	for _, s := range suggests {
+		if _, ok := seen[s.Text]; !ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,93 @@
+package kube
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/c-bata/go-prompt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestConfigMapCompleter returns the Completer with ConfigMaps
+// 'web-config' and 'db-config' in the default namespace.
+func newTestConfigMapCompleter(t *testing.T, contextName string) *Completer {
+	t.Helper()
+	c := &Completer{
+		context: contextName,
+		client:  fake.NewSimpleClientset(),
+		metadataClient: newTestMetadataClient(t,
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web-config", Namespace: "default"},
+			},
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "db-config", Namespace: "default"},
+			},
+		),
+	}
+	waitForSuggestions(t, func() []prompt.Suggest {
+		return c.getObjectSuggestions(configMapResource, "default")
+	})
+	return c
+}
+
+func TestTypedArgumentSuggestions(t *testing.T) {
+	c := newTestConfigMapCompleter(t, "test-typed-argument")
+
+	var scenarioTable = []struct {
+		arg      string
+		expected []string
+		ok       bool
+	}{
+		{arg: "cm/", expected: []string{"cm/db-config", "cm/web-config"}, ok: true},
+		{arg: "configmaps/we", expected: []string{"configmaps/web-config"}, ok: true},
+		{arg: "pods,svc,configm", expected: []string{"pods,svc,configmaps"}, ok: true},
+		{arg: "pods,pod", expected: []string{"pods,poddisruptionbudgets", "pods,podtemplates"}, ok: true},
+		{arg: "/tmp", ok: false},
+		{arg: "a,b", ok: false},
+		{arg: "web", ok: false},
+	}
+
+	for _, s := range scenarioTable {
+		actual, ok := c.typedArgumentSuggestions(context.TODO(), "default", s.arg)
+		if ok != s.ok {
+			t.Errorf("%q: should be ok=%t, but got %t", s.arg, s.ok, ok)
+			continue
+		}
+		var texts []string
+		for i := range actual {
+			texts = append(texts, actual[i].Text)
+		}
+		if !reflect.DeepEqual(texts, s.expected) {
+			t.Errorf("%q: should be %v, but got %v", s.arg, s.expected, texts)
+		}
+	}
+}
+
+func TestArgumentsCompleterExcludesTypedNames(t *testing.T) {
+	c := newTestConfigMapCompleter(t, "test-typed-names")
+
+	var scenarioTable = []struct {
+		args     []string
+		expected []string
+	}{
+		{args: []string{"delete", "cm", ""}, expected: []string{"db-config", "web-config"}},
+		{args: []string{"delete", "cm", "web-config", ""}, expected: []string{"db-config"}},
+		{args: []string{"get", "cm", "db-config", "web-config", ""}, expected: nil},
+		{args: []string{"describe", "cm/db-config", "cm/"}, expected: []string{"cm/web-config"}},
+	}
+
+	for _, s := range scenarioTable {
+		actual := c.argumentsCompleter(context.TODO(), "default", s.args)
+		var texts []string
+		for i := range actual {
+			texts = append(texts, actual[i].Text)
+		}
+		if !reflect.DeepEqual(texts, s.expected) {
+			t.Errorf("%q: should be %v, but got %v", s.args, s.expected, texts)
+		}
+	}
+}
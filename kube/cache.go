@@ -0,0 +1,141 @@
+package kube
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/c-bata/kube-prompt/internal/debug"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+const waitForSyncTimeout = 3 * time.Second
+
+// objectCacheKey identifies a set of objects watched by one informer.
+// namespace is empty for cluster-scoped resources.
+type objectCacheKey struct {
+	context   string
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+// objectCache keeps informers which are started lazily at the first completion
+// and then kept up to date by watches.
+type objectCache struct {
+	mu        sync.Mutex
+	informers map[objectCacheKey]cache.SharedIndexInformer
+	stopChs   map[objectCacheKey]chan struct{}
+}
+
+var objects = &objectCache{
+	informers: make(map[objectCacheKey]cache.SharedIndexInformer),
+	stopChs:   make(map[objectCacheKey]chan struct{}),
+}
+
+func (c *objectCache) informer(key objectCacheKey, newInformer func() (cache.SharedIndexInformer, error)) (cache.SharedIndexInformer, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if i, ok := c.informers[key]; ok {
+		return i, true
+	}
+
+	i, err := newInformer()
+	if err != nil {
+		debug.Log(err.Error())
+		return nil, false
+	}
+	_ = i.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		debug.Log(key.gvr.String() + ": " + err.Error())
+	})
+	_ = i.SetTransform(stripObject)
+	stopCh := make(chan struct{})
+	go i.Run(stopCh)
+	c.informers[key] = i
+	c.stopChs[key] = stopCh
+	return i, true
+}
+
+// stop stops all informers of the context.
+func (c *objectCache) stop(contextName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.informers {
+		if key.context != contextName {
+			continue
+		}
+		close(c.stopChs[key])
+		delete(c.informers, key)
+		delete(c.stopChs, key)
+	}
+}
+
+// list returns the cached objects. It returns nothing until the first list
+// of the informer is finished.
+func (c *objectCache) list(key objectCacheKey, newInformer func() (cache.SharedIndexInformer, error)) []interface{} {
+	i, ok := c.informer(key, newInformer)
+	if !ok {
+		return nil
+	}
+	return i.GetStore().List()
+}
+
+// listSynced is the same as list, but waits for the first list of the informer
+// up to the timeout.
+func (c *objectCache) listSynced(key objectCacheKey, newInformer func() (cache.SharedIndexInformer, error), timeout time.Duration) ([]interface{}, bool) {
+	i, ok := c.informer(key, newInformer)
+	if !ok {
+		return nil, false
+	}
+	stopCh := make(chan struct{})
+	timer := time.AfterFunc(timeout, func() { close(stopCh) })
+	defer timer.Stop()
+	if !cache.WaitForCacheSync(stopCh, i.HasSynced) {
+		return nil, false
+	}
+	return i.GetStore().List(), true
+}
+
+// stripObject drops the fields which are never used for completion
+// to reduce the memory usage of the cache.
+func stripObject(obj interface{}) (interface{}, error) {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		// e.g. cache.DeletedFinalStateUnknown
+		return obj, nil
+	}
+	o.SetManagedFields(nil)
+	if a := o.GetAnnotations(); a != nil {
+		delete(a, corev1.LastAppliedConfigAnnotation)
+	}
+	return obj, nil
+}
+
+func newMetadataInformer(client metadata.Interface, gvr schema.GroupVersionResource, namespace string) func() (cache.SharedIndexInformer, error) {
+	return func() (cache.SharedIndexInformer, error) {
+		if client == nil {
+			return nil, errors.New("no clients for the context")
+		}
+		return metadatainformer.NewFilteredMetadataInformer(client, gvr, namespace, 0, cache.Indexers{}, nil).Informer(), nil
+	}
+}
+
+func objectsToSuggestions(l []interface{}, toSuggest func(obj interface{}) prompt.Suggest) []prompt.Suggest {
+	if len(l) == 0 {
+		return []prompt.Suggest{}
+	}
+	s := make([]prompt.Suggest, 0, len(l))
+	for i := range l {
+		s = append(s, toSuggest(l[i]))
+	}
+	sort.Slice(s, func(i, j int) bool {
+		return s[i].Text < s[j].Text
+	})
+	return s
+}
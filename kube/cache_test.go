@@ -0,0 +1,110 @@
+package kube
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+)
+
+func waitForSuggestions(t *testing.T, f func() []prompt.Suggest) []prompt.Suggest {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if s := f(); len(s) > 0 {
+			return s
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("timed out waiting for the informer")
+	return nil
+}
+
+func newTestMetadataClient(t *testing.T, objects ...runtime.Object) *metadatafake.FakeMetadataClient {
+	t.Helper()
+	scheme := metadatafake.NewTestScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return metadatafake.NewSimpleMetadataClient(scheme, objects...)
+}
+
+func TestGetObjectSuggestions(t *testing.T) {
+	c := &Completer{
+		context: "test-cache",
+		client: fake.NewSimpleClientset(
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{
+					{Name: "web", Ports: []corev1.ContainerPort{{ContainerPort: 8080}}},
+				}},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+		),
+		metadataClient: newTestMetadataClient(t,
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web-2", Namespace: "default"},
+			},
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web-1", Namespace: "default"},
+			},
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"},
+			},
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "kube-system"},
+			},
+		),
+	}
+
+	// Phases are shown for the pods fetched with their statuses.
+	actual := waitForSuggestions(t, func() []prompt.Suggest {
+		s := c.getObjectSuggestions(podResource, "default")
+		if len(s) == 0 || s[0].Description == "" {
+			return nil
+		}
+		return s
+	})
+	expected := []prompt.Suggest{
+		{Text: "web-1", Description: "Running"},
+		{Text: "web-2"},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected:\n%#v\n\ngot:\n%#v\n", expected, actual)
+	}
+
+	// Specs are fetched only for containers and ports.
+	actual = waitForSuggestions(t, func() []prompt.Suggest {
+		return c.getPortsFromPodName("default", "web-1")
+	})
+	expected = []prompt.Suggest{{Text: "8080:8080"}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected:\n%#v\n\ngot:\n%#v\n", expected, actual)
+	}
+	actual = waitForSuggestions(t, func() []prompt.Suggest {
+		return c.getContainerNamesFromCachedPods("default")
+	})
+	expected = []prompt.Suggest{{Text: "web", Description: "Pod Name: web-1"}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected:\n%#v\n\ngot:\n%#v\n", expected, actual)
+	}
+
+	actual = waitForSuggestions(t, func() []prompt.Suggest {
+		return c.getResourceNameSuggestions(context.TODO(), "kube-system", "cm")
+	})
+	expected = []prompt.Suggest{{Text: "config"}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected:\n%#v\n\ngot:\n%#v\n", expected, actual)
+	}
+}
@@ -0,0 +1,95 @@
+package kube
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/c-bata/kube-prompt/internal/debug"
+)
+
+const cobraCompleteTimeout = 2 * time.Second
+
+// ShellCompDirective of cobra, printed at the last line of 'kubectl __complete'.
+const (
+	shellCompDirectiveError         = 1
+	shellCompDirectiveFilterFileExt = 8
+	shellCompDirectiveFilterDirs    = 16
+)
+
+var cobraCompletions = new(sync.Map)
+
+// getCobraSuggestions asks kubectl itself for the completion of the word under
+// the cursor through the hidden '__complete' command, which kubectl uses for
+// shell completion. It covers any command including plugins. It starts to run
+// kubectl in background and returns nothing until the result is available.
+func (c *Completer) getCobraSuggestions(namespace string, args []string) []prompt.Suggest {
+	// The last word is filtered here, so that results are cached regardless of it.
+	request := append(append([]string{}, args[:len(args)-1]...), "")
+	if namespace != "" && !hasNamespaceFlag(args) {
+		request = append([]string{"--namespace", namespace}, request...)
+	}
+	key := c.context + "\x00" + strings.Join(request, "\x00")
+
+	go c.fetchCobraSuggestions(key, request)
+	x, ok := cobraCompletions.Load(key)
+	if !ok {
+		return []prompt.Suggest{}
+	}
+	return prompt.FilterHasPrefix(x.([]prompt.Suggest), args[len(args)-1], true)
+}
+
+func (c *Completer) fetchCobraSuggestions(key string, request []string) {
+	if !shouldFetch("cobra_complete_" + key) {
+		return
+	}
+	updateLastFetchedAt("cobra_complete_" + key)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cobraCompleteTimeout)
+	defer cancel()
+	args := append(append(c.kubectlArgs(), "__complete"), request...)
+	cmd := exec.CommandContext(ctx, "kubectl", args...)
+	cmd.Env = append(os.Environ(), "KUBECTL_ACTIVE_HELP=0")
+	out, err := cmd.Output()
+	if err != nil {
+		debug.Log("kubectl __complete: " + err.Error())
+		return
+	}
+	cobraCompletions.Store(key, parseCobraCompletions(string(out)))
+}
+
+// parseCobraCompletions converts the output of '__complete' like:
+//
+//	nginx-6799fc88d8-5dqbx	Running
+//	nginx-6799fc88d8-q2xnr	Running
+//	:4
+func parseCobraCompletions(out string) []prompt.Suggest {
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	last := lines[len(lines)-1]
+	if !strings.HasPrefix(last, ":") {
+		return []prompt.Suggest{}
+	}
+	directive, err := strconv.Atoi(last[1:])
+	if err != nil || directive&shellCompDirectiveError != 0 {
+		return []prompt.Suggest{}
+	}
+	if directive&(shellCompDirectiveFilterFileExt|shellCompDirectiveFilterDirs) != 0 {
+		// They are the hints for the file completion of shells.
+		return []prompt.Suggest{}
+	}
+
+	suggests := make([]prompt.Suggest, 0, len(lines)-1)
+	for _, l := range lines[:len(lines)-1] {
+		if l == "" || strings.HasPrefix(l, "_activeHelp_") {
+			continue
+		}
+		text, description, _ := strings.Cut(l, "\t")
+		suggests = append(suggests, prompt.Suggest{Text: text, Description: description})
+	}
+	return suggests
+}
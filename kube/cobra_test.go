@@ -0,0 +1,79 @@
+package kube
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/c-bata/go-prompt"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestParseCobraCompletions(t *testing.T) {
+	var scenarioTable = []struct {
+		input    string
+		expected []prompt.Suggest
+	}{
+		{
+			input: "nginx-1\tRunning\nnginx-2\n:4\n",
+			expected: []prompt.Suggest{
+				{Text: "nginx-1", Description: "Running"},
+				{Text: "nginx-2"},
+			},
+		},
+		{
+			input:    "_activeHelp_ Requires a resource type\n:4\n",
+			expected: []prompt.Suggest{},
+		},
+		{
+			input:    "yaml\nyml\n:8\n",
+			expected: []prompt.Suggest{},
+		},
+		{
+			input:    ":1\n",
+			expected: []prompt.Suggest{},
+		},
+		{
+			input:    "unexpected output",
+			expected: []prompt.Suggest{},
+		},
+	}
+
+	for _, s := range scenarioTable {
+		actual := parseCobraCompletions(s.input)
+		if !reflect.DeepEqual(actual, s.expected) {
+			t.Errorf("%q: should be %#v, but got %#v", s.input, s.expected, actual)
+		}
+	}
+}
+
+func TestGetCobraSuggestions(t *testing.T) {
+	dir := t.TempDir()
+	script := "#!/bin/sh\nsleep 0.2\nprintf 'web-1\\tRunning\\nweb-2\\tPending\\n:4\\n'\n"
+	if err := os.WriteFile(filepath.Join(dir, "kubectl"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	c := &Completer{
+		context:      "test-cobra",
+		loadingRules: &clientcmd.ClientConfigLoadingRules{},
+		overrides:    &clientcmd.ConfigOverrides{},
+	}
+	args := []string{"plugin-foo", "web-"}
+
+	// kubectl runs in background not to block the input.
+	if actual := c.getCobraSuggestions("default", args); len(actual) != 0 {
+		t.Errorf("Should be empty until kubectl finishes, but got %v", actual)
+	}
+	actual := waitForSuggestions(t, func() []prompt.Suggest {
+		return c.getCobraSuggestions("default", args)
+	})
+	expected := []prompt.Suggest{
+		{Text: "web-1", Description: "Running"},
+		{Text: "web-2", Description: "Pending"},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %#v, but got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,1604 @@
+// Code generated by 'command-gen' from k8s.io/kubectl v0.30.3. DO NOT EDIT.
+
+package kube
+
+// bundledKubectlVersion is the version of kubectl which kubectlCommand is generated from.
+const bundledKubectlVersion = "v1.30.3"
+
+var kubectlCommand = command{
+	name:        "kubectl",
+	description: "kubectl controls the Kubernetes cluster manager",
+	options: []option{
+		{name: "as", valueType: "string", description: "Username to impersonate for the operation. User could be a regular user or a service account in a namespace."},
+		{name: "as-group", valueType: "stringArray", defaultValue: "[]", description: "Group to impersonate for the operation, this flag can be repeated to specify multiple groups."},
+		{name: "as-uid", valueType: "string", description: "UID to impersonate for the operation."},
+		{name: "cache-dir", valueType: "string", defaultValue: "$HOME/.kube/cache", description: "Default cache directory"},
+		{name: "certificate-authority", valueType: "string", description: "Path to a cert file for the certificate authority"},
+		{name: "client-certificate", valueType: "string", description: "Path to a client certificate file for TLS"},
+		{name: "client-key", valueType: "string", description: "Path to a client key file for TLS"},
+		{name: "cluster", valueType: "string", description: "The name of the kubeconfig cluster to use"},
+		{name: "context", valueType: "string", description: "The name of the kubeconfig context to use"},
+		{name: "disable-compression", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, opt-out of response compression for all requests to the server"},
+		{name: "insecure-skip-tls-verify", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the server's certificate will not be checked for validity. This will make your HTTPS connections insecure"},
+		{name: "kubeconfig", valueType: "string", description: "Path to the kubeconfig file to use for CLI requests."},
+		{name: "log-flush-frequency", valueType: "duration", defaultValue: "5s", description: "Maximum number of seconds between log flushes"},
+		{name: "match-server-version", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Require server version to match client version"},
+		{name: "namespace", shorthand: "n", valueType: "string", description: "If present, the namespace scope for this CLI request"},
+		{name: "password", valueType: "string", description: "Password for basic authentication to the API server"},
+		{name: "profile", valueType: "string", defaultValue: "none", description: "Name of profile to capture. One of (none|cpu|heap|goroutine|threadcreate|block|mutex)"},
+		{name: "profile-output", valueType: "string", defaultValue: "profile.pprof", description: "Name of the file to write the profile to"},
+		{name: "request-timeout", valueType: "string", defaultValue: "0", description: "The length of time to wait before giving up on a single server request. Non-zero values should contain a corresponding time unit (e.g. 1s, 2m, 3h). A value of zero means don't timeout requests."},
+		{name: "server", shorthand: "s", valueType: "string", description: "The address and port of the Kubernetes API server"},
+		{name: "tls-server-name", valueType: "string", description: "Server name to use for server certificate validation. If it is not provided, the hostname used to contact the server is used"},
+		{name: "token", valueType: "string", description: "Bearer token for authentication to the API server"},
+		{name: "user", valueType: "string", description: "The name of the kubeconfig user to use"},
+		{name: "username", valueType: "string", description: "Username for basic authentication to the API server"},
+		{name: "v", shorthand: "v", valueType: "Level", defaultValue: "0", description: "number for the log level verbosity"},
+		{name: "vmodule", valueType: "moduleSpec", description: "comma-separated list of pattern=N settings for file-filtered logging (only works for the default text log format)"},
+		{name: "warnings-as-errors", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Treat warnings received from the server as errors and exit with a non-zero exit code"},
+	},
+	subcommands: []command{
+		{
+			name:        "alpha",
+			description: "Commands for features in alpha",
+			options:     []option{},
+		},
+		{
+			name:        "annotate",
+			description: "Update the annotations on a resource",
+			options: []option{
+				{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources, in the namespace of the specified resource types."},
+				{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, check the specified action in all namespaces."},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-annotate", description: "Name of the manager used to track field ownership."},
+				{name: "field-selector", valueType: "string", description: "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to update the annotation"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "list", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, display the annotations for a given resource."},
+				{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, annotation will NOT contact api-server but run locally."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "overwrite", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, allow annotations to be overwritten, otherwise reject annotation updates that overwrite existing annotations."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "resource-version", valueType: "string", description: "If non-empty, the annotation update will only succeed if this is the current resource-version for the object. Only valid when specifying a single resource."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+			},
+		},
+		{
+			name:        "api-resources",
+			description: "Print the supported API resources on the server",
+			options: []option{
+				{name: "api-group", valueType: "string", description: "Limit to resources in the specified API group."},
+				{name: "cached", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Use the cached list of resources if available."},
+				{name: "categories", valueType: "stringSlice", defaultValue: "[]", description: "Limit to resources that belong to the specified categories."},
+				{name: "namespaced", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If false, non-namespaced resources will be returned, otherwise returning namespaced resources by default."},
+				{name: "no-headers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When using the default or custom-column output format, don't print headers (default print headers)."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (wide, name)."},
+				{name: "sort-by", valueType: "string", description: "If non-empty, sort list of resources using specified field. The field can be either 'name' or 'kind'."},
+				{name: "verbs", valueType: "stringSlice", defaultValue: "[]", description: "Limit to resources that support the specified verbs."},
+			},
+		},
+		{
+			name:        "api-versions",
+			description: "Print the supported API versions on the server, in the form of \"group/version\"",
+			options:     []option{},
+		},
+		{
+			name:        "apply",
+			description: "Apply a configuration to a resource by file name or stdin",
+			options: []option{
+				{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources in the namespace of the specified resource types."},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "cascade", valueType: "string", defaultValue: "background", noOptDefault: "background", description: "Must be \"background\", \"orphan\", or \"foreground\". Selects the deletion cascading strategy for the dependents (e.g. Pods created by a ReplicationController). Defaults to background."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-client-side-apply", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "The files that contain the configurations to apply."},
+				{name: "force", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, immediately remove resources from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation."},
+				{name: "force-conflicts", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, server-side apply will force the changes against conflicts."},
+				{name: "grace-period", valueType: "int", defaultValue: "-1", description: "Period of time in seconds given to the resource to terminate gracefully. Ignored if negative. Set to 1 for immediate shutdown. Can only be set to 0 when --force is true (force deletion)."},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process a kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "openapi-patch", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, use openapi to calculate diff when the openapi presents and the resource can be found in the openapi spec. Otherwise, fall back to use baked-in types."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "overwrite", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "Automatically resolve conflicts between the modified and live configuration by using values from the modified configuration"},
+				{name: "prune", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Automatically delete resource objects, that do not appear in the configs and are created by either apply or create --save-config. Should be used with either -l or --all."},
+				{name: "prune-allowlist", valueType: "stringArray", defaultValue: "[]", description: "Overwrite the default allowlist with <group/version/kind> for --prune"},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "server-side", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, apply runs in the server instead of the client."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "timeout", valueType: "duration", defaultValue: "0s", description: "The length of time to wait before giving up on a delete, zero means determine a timeout from the size of the object"},
+				{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+				{name: "wait", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, wait for resources to be gone before returning. This waits for finalizers."},
+			},
+			subcommands: []command{
+				{
+					name:        "edit-last-applied",
+					description: "Edit latest last-applied-configuration annotations of a resource/object",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-client-side-apply", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files to use to edit the resource"},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+						{name: "windows-line-endings", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Defaults to the line ending native to your platform."},
+					},
+				},
+				{
+					name:        "set-last-applied",
+					description: "Set the last-applied-configuration annotation on a live object to match the contents of a file",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "create-annotation", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Will create 'last-applied-configuration' annotations if current objects doesn't have one"},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files that contains the last-applied-configuration annotations"},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "view-last-applied",
+					description: "View the latest last-applied-configuration annotations of a resource/object",
+					options: []option{
+						{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources in the namespace of the specified resource types"},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files that contains the last-applied-configuration annotations"},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", defaultValue: "yaml", description: "Output format. Must be one of (yaml, json)"},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+					},
+				},
+			},
+		},
+		{
+			name:        "attach",
+			description: "Attach to a running container",
+			options: []option{
+				{name: "container", shorthand: "c", valueType: "string", description: "Container name. If omitted, use the kubectl.kubernetes.io/default-container annotation for selecting the container to be attached or the first container in the pod will be chosen"},
+				{name: "pod-running-timeout", valueType: "duration", defaultValue: "1m0s", description: "The length of time (like 5s, 2m, or 3h, higher than zero) to wait until at least one pod is running"},
+				{name: "quiet", shorthand: "q", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Only print output from the remote session"},
+				{name: "stdin", shorthand: "i", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Pass stdin to the container"},
+				{name: "tty", shorthand: "t", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Stdin is a TTY"},
+			},
+		},
+		{
+			name:        "auth",
+			description: "Inspect authorization",
+			options:     []option{},
+			subcommands: []command{
+				{
+					name:        "can-i",
+					description: "Check whether an action is allowed",
+					options: []option{
+						{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, check the specified action in all namespaces."},
+						{name: "list", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, prints all allowed actions."},
+						{name: "no-headers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, prints allowed actions without headers"},
+						{name: "quiet", shorthand: "q", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, suppress output and just return the exit code."},
+						{name: "subresource", valueType: "string", description: "SubResource such as pod/log or deployment/scale"},
+					},
+				},
+				{
+					name:        "reconcile",
+					description: "Reconciles rules for RBAC role, role binding, cluster role, and cluster role binding objects",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to reconcile."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "remove-extra-permissions", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, removes extra permissions added to roles"},
+						{name: "remove-extra-subjects", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, removes extra subjects added to rolebindings"},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "whoami",
+					description: "Experimental: Check self subject attributes",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+			},
+		},
+		{
+			name:        "autoscale",
+			description: "Auto-scale a deployment, replica set, stateful set, or replication controller",
+			options: []option{
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "cpu-percent", valueType: "int32", defaultValue: "-1", description: "The target average CPU utilization (represented as a percent of requested CPU) over all the pods. If it's not specified or negative, a default autoscaling policy will be used."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-autoscale", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to autoscale."},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "max", valueType: "int32", defaultValue: "-1", description: "The upper limit for the number of pods that can be set by the autoscaler. Required."},
+				{name: "min", valueType: "int32", defaultValue: "-1", description: "The lower limit for the number of pods that can be set by the autoscaler. If it's not specified or negative, the server will apply a default value."},
+				{name: "name", valueType: "string", description: "The name for the newly created object. If not specified, the name of the input resource will be used."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+			},
+		},
+		{
+			name:        "certificate",
+			description: "Modify certificate resources",
+			options:     []option{},
+			subcommands: []command{
+				{
+					name:        "approve",
+					description: "Approve a certificate signing request",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to update"},
+						{name: "force", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Update the CSR even if it is already approved."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "deny",
+					description: "Deny a certificate signing request",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to update"},
+						{name: "force", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Update the CSR even if it is already denied."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+			},
+		},
+		{
+			name:        "cluster-info",
+			description: "Display cluster information",
+			options:     []option{},
+			subcommands: []command{
+				{
+					name:        "dump",
+					description: "Dump relevant information for debugging and diagnosis",
+					options: []option{
+						{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, dump all namespaces.  If true, --namespaces is ignored."},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "namespaces", valueType: "stringSlice", defaultValue: "[]", description: "A comma separated list of namespaces to dump."},
+						{name: "output", shorthand: "o", valueType: "string", defaultValue: "json", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "output-directory", valueType: "string", description: "Where to output the files.  If empty or '-' uses stdout, otherwise creates a directory hierarchy in that directory"},
+						{name: "pod-running-timeout", valueType: "duration", defaultValue: "20s", description: "The length of time (like 5s, 2m, or 3h, higher than zero) to wait until at least one pod is running"},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+			},
+		},
+		{
+			name:        "completion",
+			description: "Output shell completion code for the specified shell (bash, zsh, fish, or powershell)",
+			options:     []option{},
+		},
+		{
+			name:        "config",
+			description: "Modify kubeconfig files",
+			options: []option{
+				{name: "kubeconfig", valueType: "string", description: "use a particular kubeconfig file"},
+			},
+			subcommands: []command{
+				{
+					name:        "current-context",
+					description: "Display the current-context",
+					options:     []option{},
+				},
+				{
+					name:        "delete-cluster",
+					description: "Delete the specified cluster from the kubeconfig",
+					options:     []option{},
+				},
+				{
+					name:        "delete-context",
+					description: "Delete the specified context from the kubeconfig",
+					options:     []option{},
+				},
+				{
+					name:        "delete-user",
+					description: "Delete the specified user from the kubeconfig",
+					options:     []option{},
+				},
+				{
+					name:        "get-clusters",
+					description: "Display clusters defined in the kubeconfig",
+					options:     []option{},
+				},
+				{
+					name:        "get-contexts",
+					description: "Describe one or many contexts",
+					options: []option{
+						{name: "no-headers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When using the default or custom-column output format, don't print headers (default print headers)."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (name)."},
+					},
+				},
+				{
+					name:        "get-users",
+					description: "Display users defined in the kubeconfig",
+					options:     []option{},
+				},
+				{
+					name:        "rename-context",
+					description: "Rename a context from the kubeconfig file",
+					options:     []option{},
+				},
+				{
+					name:        "set",
+					description: "Set an individual value in a kubeconfig file",
+					options: []option{
+						{name: "set-raw-bytes", valueType: "tristate", defaultValue: "false", noOptDefault: "true", description: "When writing a []byte PROPERTY_VALUE, write the given string directly without base64 decoding."},
+					},
+				},
+				{
+					name:        "set-cluster",
+					description: "Set a cluster entry in kubeconfig",
+					options: []option{
+						{name: "certificate-authority", valueType: "string", description: "Path to certificate-authority file for the cluster entry in kubeconfig"},
+						{name: "embed-certs", valueType: "tristate", defaultValue: "false", noOptDefault: "true", description: "embed-certs for the cluster entry in kubeconfig"},
+						{name: "insecure-skip-tls-verify", valueType: "tristate", defaultValue: "false", noOptDefault: "true", description: "insecure-skip-tls-verify for the cluster entry in kubeconfig"},
+						{name: "proxy-url", valueType: "string", description: "proxy-url for the cluster entry in kubeconfig"},
+						{name: "server", valueType: "string", description: "server for the cluster entry in kubeconfig"},
+						{name: "tls-server-name", valueType: "string", description: "tls-server-name for the cluster entry in kubeconfig"},
+					},
+				},
+				{
+					name:        "set-context",
+					description: "Set a context entry in kubeconfig",
+					options: []option{
+						{name: "cluster", valueType: "string", description: "cluster for the context entry in kubeconfig"},
+						{name: "current", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Modify the current context"},
+						{name: "namespace", valueType: "string", description: "namespace for the context entry in kubeconfig"},
+						{name: "user", valueType: "string", description: "user for the context entry in kubeconfig"},
+					},
+				},
+				{
+					name:        "set-credentials",
+					description: "Set a user entry in kubeconfig",
+					options: []option{
+						{name: "auth-provider", valueType: "string", description: "Auth provider for the user entry in kubeconfig"},
+						{name: "auth-provider-arg", valueType: "stringSlice", defaultValue: "[]", description: "'key=value' arguments for the auth provider"},
+						{name: "client-certificate", valueType: "string", description: "Path to client-certificate file for the user entry in kubeconfig"},
+						{name: "client-key", valueType: "string", description: "Path to client-key file for the user entry in kubeconfig"},
+						{name: "embed-certs", valueType: "tristate", defaultValue: "false", noOptDefault: "true", description: "Embed client cert/key for the user entry in kubeconfig"},
+						{name: "exec-api-version", valueType: "string", description: "API version of the exec credential plugin for the user entry in kubeconfig"},
+						{name: "exec-arg", valueType: "stringSlice", defaultValue: "[]", description: "New arguments for the exec credential plugin command for the user entry in kubeconfig"},
+						{name: "exec-command", valueType: "string", description: "Command for the exec credential plugin for the user entry in kubeconfig"},
+						{name: "exec-env", valueType: "stringArray", defaultValue: "[]", description: "'key=value' environment values for the exec credential plugin"},
+						{name: "exec-interactive-mode", valueType: "string", description: "InteractiveMode of the exec credentials plugin for the user entry in kubeconfig"},
+						{name: "exec-provide-cluster-info", valueType: "tristate", defaultValue: "false", noOptDefault: "true", description: "ProvideClusterInfo of the exec credentials plugin for the user entry in kubeconfig"},
+						{name: "password", valueType: "string", description: "password for the user entry in kubeconfig"},
+						{name: "token", valueType: "string", description: "token for the user entry in kubeconfig"},
+						{name: "username", valueType: "string", description: "username for the user entry in kubeconfig"},
+					},
+				},
+				{
+					name:        "unset",
+					description: "Unset an individual value in a kubeconfig file",
+					options:     []option{},
+				},
+				{
+					name:        "use-context",
+					aliases:     []string{"use"},
+					description: "Set the current-context in a kubeconfig file",
+					options:     []option{},
+				},
+				{
+					name:        "view",
+					description: "Display merged kubeconfig settings or a specified kubeconfig file",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "flatten", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Flatten the resulting kubeconfig file into self-contained output (useful for creating portable kubeconfig files)"},
+						{name: "merge", valueType: "tristate", defaultValue: "true", noOptDefault: "true", description: "Merge the full hierarchy of kubeconfig files"},
+						{name: "minify", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Remove all information not used by current-context from the output"},
+						{name: "output", shorthand: "o", valueType: "string", defaultValue: "yaml", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "raw", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Display raw byte data and sensitive data"},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+			},
+		},
+		{
+			name:        "cordon",
+			description: "Mark node as unschedulable",
+			options: []option{
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+			},
+		},
+		{
+			name:        "cp",
+			description: "Copy files and directories to and from containers",
+			options: []option{
+				{name: "container", shorthand: "c", valueType: "string", description: "Container name. If omitted, use the kubectl.kubernetes.io/default-container annotation for selecting the container to be attached or the first container in the pod will be chosen"},
+				{name: "no-preserve", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "The copied file/directory's ownership and permissions will not be preserved in the container"},
+				{name: "retries", valueType: "int", defaultValue: "0", description: "Set number of retries to complete a copy operation from a container. Specify 0 to disable or any negative value for infinite retrying. The default is 0 (no retry)."},
+			},
+		},
+		{
+			name:        "create",
+			description: "Create a resource from a file or from stdin",
+			options: []option{
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "edit", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Edit the API resource before creating"},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files to use to create the resource"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "raw", valueType: "string", description: "Raw URI to POST to the server.  Uses the transport specified by the kubeconfig file."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+				{name: "windows-line-endings", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Only relevant if --edit=true. Defaults to the line ending native to your platform."},
+			},
+			subcommands: []command{
+				{
+					name:        "clusterrole",
+					description: "Create a cluster role",
+					options: []option{
+						{name: "aggregation-rule", valueType: "mapStringString", description: "An aggregation label selector for combining ClusterRoles."},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "non-resource-url", valueType: "stringSlice", defaultValue: "[]", description: "A partial url that user should have access to."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "resource", valueType: "stringSlice", defaultValue: "[]", description: "Resource that the rule applies to"},
+						{name: "resource-name", valueType: "stringArray", defaultValue: "[]", description: "Resource in the white list that the rule applies to, repeat this flag for multiple items"},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+						{name: "verb", valueType: "stringSlice", defaultValue: "[]", description: "Verb that applies to the resources contained in the rule"},
+					},
+				},
+				{
+					name:        "clusterrolebinding",
+					description: "Create a cluster role binding for a particular cluster role",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "clusterrole", valueType: "string", description: "ClusterRole this ClusterRoleBinding should reference"},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "group", valueType: "stringArray", defaultValue: "[]", description: "Groups to bind to the clusterrole. The flag can be repeated to add multiple groups."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "serviceaccount", valueType: "stringArray", defaultValue: "[]", description: "Service accounts to bind to the clusterrole, in the format <namespace>:<name>. The flag can be repeated to add multiple service accounts."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "user", valueType: "stringArray", defaultValue: "[]", description: "Usernames to bind to the clusterrole. The flag can be repeated to add multiple users."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "configmap",
+					aliases:     []string{"cm"},
+					description: "Create a config map from a local file, directory or literal value",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "append-hash", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Append a hash of the configmap to its name."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "from-env-file", valueType: "stringSlice", defaultValue: "[]", description: "Specify the path to a file to read lines of key=val pairs to create a configmap."},
+						{name: "from-file", valueType: "stringSlice", defaultValue: "[]", description: "Key file can be specified using its file path, in which case file basename will be used as configmap key, or optionally with a key and file path, in which case the given key will be used.  Specifying a directory will iterate each named file in the directory whose basename is a valid configmap key."},
+						{name: "from-literal", valueType: "stringArray", defaultValue: "[]", description: "Specify a key and literal value to insert in configmap (i.e. mykey=somevalue)"},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "cronjob",
+					aliases:     []string{"cj"},
+					description: "Create a cron job with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "image", valueType: "string", description: "Image name to run."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "restart", valueType: "string", description: "job's restart policy. supported values: OnFailure, Never"},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "schedule", valueType: "string", description: "A schedule in the Cron format the job should be run with."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "deployment",
+					aliases:     []string{"deploy"},
+					description: "Create a deployment with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "image", valueType: "stringSlice", defaultValue: "[]", description: "Image names to run. A deployment can have multiple images set for multi-container pod."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "port", valueType: "int32", defaultValue: "-1", description: "The containerPort that this deployment exposes."},
+						{name: "replicas", shorthand: "r", valueType: "int32", defaultValue: "1", description: "Number of replicas to create. Default is 1."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "ingress",
+					aliases:     []string{"ing"},
+					description: "Create an ingress with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "annotation", valueType: "stringArray", defaultValue: "[]", description: "Annotation to insert in the ingress object, in the format annotation=value"},
+						{name: "class", valueType: "string", description: "Ingress Class to be used"},
+						{name: "default-backend", valueType: "string", description: "Default service for backend, in format of svcname:port"},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "rule", valueType: "stringArray", defaultValue: "[]", description: "Rule in format host/path=service:port[,tls=secretname]. Paths containing the leading character '*' are considered pathType=Prefix. tls argument is optional."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "job",
+					description: "Create a job with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "from", valueType: "string", description: "The name of the resource to create a Job from (only cronjob is supported)."},
+						{name: "image", valueType: "string", description: "Image name to run."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "namespace",
+					aliases:     []string{"ns"},
+					description: "Create a namespace with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "poddisruptionbudget",
+					aliases:     []string{"pdb"},
+					description: "Create a pod disruption budget with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "max-unavailable", valueType: "string", description: "The maximum number or percentage of unavailable pods this budget requires."},
+						{name: "min-available", valueType: "string", description: "The minimum number or percentage of available pods this budget requires."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "selector", valueType: "string", description: "A label selector to use for this budget. Only equality-based selector requirements are supported."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "priorityclass",
+					aliases:     []string{"pc"},
+					description: "Create a priority class with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "description", valueType: "string", description: "description is an arbitrary string that usually provides guidelines on when this priority class should be used."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "global-default", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "global-default specifies whether this PriorityClass should be considered as the default priority."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "preemption-policy", valueType: "string", defaultValue: "PreemptLowerPriority", description: "preemption-policy is the policy for preempting pods with lower priority."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+						{name: "value", valueType: "int32", defaultValue: "0", description: "the value of this priority class."},
+					},
+				},
+				{
+					name:        "quota",
+					aliases:     []string{"resourcequota"},
+					description: "Create a quota with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "hard", valueType: "string", description: "A comma-delimited set of resource=quantity pairs that define a hard limit."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "scopes", valueType: "string", description: "A comma-delimited set of quota scopes that must all match each object tracked by the quota."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "role",
+					description: "Create a role with single rule",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "resource", valueType: "stringSlice", defaultValue: "[]", description: "Resource that the rule applies to"},
+						{name: "resource-name", valueType: "stringArray", defaultValue: "[]", description: "Resource in the white list that the rule applies to, repeat this flag for multiple items"},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+						{name: "verb", valueType: "stringSlice", defaultValue: "[]", description: "Verb that applies to the resources contained in the rule"},
+					},
+				},
+				{
+					name:        "rolebinding",
+					description: "Create a role binding for a particular role or cluster role",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "clusterrole", valueType: "string", description: "ClusterRole this RoleBinding should reference"},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "group", valueType: "stringArray", defaultValue: "[]", description: "Groups to bind to the role. The flag can be repeated to add multiple groups."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "role", valueType: "string", description: "Role this RoleBinding should reference"},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "serviceaccount", valueType: "stringArray", defaultValue: "[]", description: "Service accounts to bind to the role, in the format <namespace>:<name>. The flag can be repeated to add multiple service accounts."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "user", valueType: "stringArray", defaultValue: "[]", description: "Usernames to bind to the role. The flag can be repeated to add multiple users."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "secret",
+					description: "Create a secret using a specified subcommand",
+					options:     []option{},
+					subcommands: []command{
+						{
+							name:        "docker-registry",
+							description: "Create a secret for use with a Docker registry",
+							options: []option{
+								{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+								{name: "append-hash", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Append a hash of the secret to its name."},
+								{name: "docker-email", valueType: "string", description: "Email for Docker registry"},
+								{name: "docker-password", valueType: "string", description: "Password for Docker registry authentication"},
+								{name: "docker-server", valueType: "string", defaultValue: "https://index.docker.io/v1/", description: "Server location for Docker registry"},
+								{name: "docker-username", valueType: "string", description: "Username for Docker registry authentication"},
+								{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+								{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+								{name: "from-file", valueType: "stringSlice", defaultValue: "[]", description: "Key files can be specified using their file path, in which case a default name will be given to them, or optionally with a name and file path, in which case the given name will be used.  Specifying a directory will iterate each named file in the directory that is a valid secret key."},
+								{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+								{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+								{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+								{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+								{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+							},
+						},
+						{
+							name:        "generic",
+							description: "Create a secret from a local file, directory, or literal value",
+							options: []option{
+								{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+								{name: "append-hash", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Append a hash of the secret to its name."},
+								{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+								{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+								{name: "from-env-file", valueType: "stringSlice", defaultValue: "[]", description: "Specify the path to a file to read lines of key=val pairs to create a secret."},
+								{name: "from-file", valueType: "stringSlice", defaultValue: "[]", description: "Key files can be specified using their file path, in which case a default name will be given to them, or optionally with a name and file path, in which case the given name will be used.  Specifying a directory will iterate each named file in the directory that is a valid secret key."},
+								{name: "from-literal", valueType: "stringArray", defaultValue: "[]", description: "Specify a key and literal value to insert in secret (i.e. mykey=somevalue)"},
+								{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+								{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+								{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+								{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+								{name: "type", valueType: "string", description: "The type of secret to create"},
+								{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+							},
+						},
+						{
+							name:        "tls",
+							description: "Create a TLS secret",
+							options: []option{
+								{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+								{name: "append-hash", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Append a hash of the secret to its name."},
+								{name: "cert", valueType: "string", description: "Path to PEM encoded public key certificate."},
+								{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+								{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+								{name: "key", valueType: "string", description: "Path to private key associated with given certificate."},
+								{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+								{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+								{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+								{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+								{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+							},
+						},
+					},
+				},
+				{
+					name:        "service",
+					aliases:     []string{"svc"},
+					description: "Create a service using a specified subcommand",
+					options:     []option{},
+					subcommands: []command{
+						{
+							name:        "clusterip",
+							description: "Create a ClusterIP service",
+							options: []option{
+								{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+								{name: "clusterip", valueType: "string", description: "Assign your own ClusterIP or set to 'None' for a 'headless' service (no loadbalancing)."},
+								{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+								{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+								{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+								{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+								{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+								{name: "tcp", valueType: "stringSlice", defaultValue: "[]", description: "Port pairs can be specified as '<port>:<targetPort>'."},
+								{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+								{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+							},
+						},
+						{
+							name:        "externalname",
+							description: "Create an ExternalName service",
+							options: []option{
+								{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+								{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+								{name: "external-name", valueType: "string", description: "External name of service"},
+								{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+								{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+								{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+								{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+								{name: "tcp", valueType: "stringSlice", defaultValue: "[]", description: "Port pairs can be specified as '<port>:<targetPort>'."},
+								{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+								{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+							},
+						},
+						{
+							name:        "loadbalancer",
+							description: "Create a LoadBalancer service",
+							options: []option{
+								{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+								{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+								{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+								{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+								{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+								{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+								{name: "tcp", valueType: "stringSlice", defaultValue: "[]", description: "Port pairs can be specified as '<port>:<targetPort>'."},
+								{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+								{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+							},
+						},
+						{
+							name:        "nodeport",
+							description: "Create a NodePort service",
+							options: []option{
+								{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+								{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+								{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+								{name: "node-port", valueType: "int", defaultValue: "0", description: "Port used to expose the service on each node in a cluster."},
+								{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+								{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+								{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+								{name: "tcp", valueType: "stringSlice", defaultValue: "[]", description: "Port pairs can be specified as '<port>:<targetPort>'."},
+								{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+								{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+							},
+						},
+					},
+				},
+				{
+					name:        "serviceaccount",
+					aliases:     []string{"sa"},
+					description: "Create a service account with the specified name",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-create", description: "Name of the manager used to track field ownership."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+					},
+				},
+				{
+					name:        "token",
+					description: "Request a service account token",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "audience", valueType: "stringArray", defaultValue: "[]", description: "Audience of the requested token. If unset, defaults to requesting a token for use with the Kubernetes API server. May be repeated to request a token valid for multiple audiences."},
+						{name: "bound-object-kind", valueType: "string", description: "Kind of an object to bind the token to. Supported kinds are Pod, Secret. If set, --bound-object-name must be provided."},
+						{name: "bound-object-name", valueType: "string", description: "Name of an object to bind the token to. The token will expire when the object is deleted. Requires --bound-object-kind."},
+						{name: "bound-object-uid", valueType: "string", description: "UID of an object to bind the token to. Requires --bound-object-kind and --bound-object-name. If unset, the UID of the existing object is used."},
+						{name: "duration", valueType: "duration", defaultValue: "0s", description: "Requested lifetime of the issued token. If not set or if set to 0, the lifetime will be determined by the server automatically. The server may return a token with a longer or shorter lifetime."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+			},
+		},
+		{
+			name:        "debug",
+			description: "Create debugging sessions for troubleshooting workloads and nodes",
+			options: []option{
+				{name: "arguments-only", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If specified, everything after -- will be passed to the new container as Args instead of Command."},
+				{name: "attach", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, wait for the container to start running, and then attach as if 'kubectl attach ...' were called.  Default false, unless '-i/--stdin' is set, in which case the default is true."},
+				{name: "container", shorthand: "c", valueType: "string", description: "Container name to use for debug container."},
+				{name: "copy-to", valueType: "string", description: "Create a copy of the target Pod with this name."},
+				{name: "env", valueType: "stringToString", defaultValue: "[]", description: "Environment variables to set in the container."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "identifying the resource to debug"},
+				{name: "image", valueType: "string", description: "Container image to use for debug container."},
+				{name: "image-pull-policy", valueType: "string", description: "The image pull policy for the container. If left empty, this value will not be specified by the client and defaulted by the server."},
+				{name: "profile", valueType: "string", defaultValue: "legacy", description: "Options are \"legacy\", \"general\", \"baseline\", \"netadmin\", \"restricted\" or \"sysadmin\"."},
+				{name: "quiet", shorthand: "q", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, suppress informational messages."},
+				{name: "replace", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When used with '--copy-to', delete the original Pod."},
+				{name: "same-node", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When used with '--copy-to', schedule the copy of target Pod on the same node."},
+				{name: "set-image", valueType: "stringToString", defaultValue: "[]", description: "When used with '--copy-to', a list of name=image pairs for changing container images, similar to how 'kubectl set image' works."},
+				{name: "share-processes", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "When used with '--copy-to', enable process namespace sharing in the copy."},
+				{name: "stdin", shorthand: "i", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Keep stdin open on the container(s) in the pod, even if nothing is attached."},
+				{name: "target", valueType: "string", description: "When using an ephemeral container, target processes in this container name."},
+				{name: "tty", shorthand: "t", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Allocate a TTY for the debugging container."},
+			},
+		},
+		{
+			name:        "delete",
+			description: "Delete resources by file names, stdin, resources and names, or by resources and label selector",
+			options: []option{
+				{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Delete all resources, in the namespace of the specified resource types."},
+				{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace."},
+				{name: "cascade", valueType: "string", defaultValue: "background", noOptDefault: "background", description: "Must be \"background\", \"orphan\", or \"foreground\". Selects the deletion cascading strategy for the dependents (e.g. Pods created by a ReplicationController). Defaults to background."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-selector", valueType: "string", description: "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "containing the resource to delete."},
+				{name: "force", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, immediately remove resources from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation."},
+				{name: "grace-period", valueType: "int", defaultValue: "-1", description: "Period of time in seconds given to the resource to terminate gracefully. Ignored if negative. Set to 1 for immediate shutdown. Can only be set to 0 when --force is true (force deletion)."},
+				{name: "ignore-not-found", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Treat \"resource not found\" as a successful delete. Defaults to \"true\" when --all is specified."},
+				{name: "interactive", shorthand: "i", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, delete resource only when user confirms."},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process a kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "now", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, resources are signaled for immediate shutdown (same as --grace-period=1)."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output mode. Use \"-o name\" for shorter output (resource/name)."},
+				{name: "raw", valueType: "string", description: "Raw URI to DELETE to the server.  Uses the transport specified by the kubeconfig file."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "timeout", valueType: "duration", defaultValue: "0s", description: "The length of time to wait before giving up on a delete, zero means determine a timeout from the size of the object"},
+				{name: "wait", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, wait for resources to be gone before returning. This waits for finalizers."},
+			},
+		},
+		{
+			name:        "describe",
+			description: "Show details of a specific resource or group of resources",
+			options: []option{
+				{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace."},
+				{name: "chunk-size", valueType: "int64", defaultValue: "500", description: "Return large lists in chunks rather than all at once. Pass 0 to disable. This flag is beta and may change in the future."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files containing the resource to describe"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "show-events", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, display events related to the described object."},
+			},
+		},
+		{
+			name:        "diff",
+			description: "Diff the live version against a would-be applied version",
+			options: []option{
+				{name: "concurrency", valueType: "int", defaultValue: "1", description: "Number of objects to process in parallel when diffing against the live version. Larger number = faster, but more memory, I/O and CPU over that shorter period of time."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-client-side-apply", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files contains the configuration to diff"},
+				{name: "force-conflicts", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, server-side apply will force the changes against conflicts."},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "prune", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Include resources that would be deleted by pruning. Can be used with -l and default shows all resources would be pruned"},
+				{name: "prune-allowlist", valueType: "stringArray", defaultValue: "[]", description: "Overwrite the default allowlist with <group/version/kind> for --prune"},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "server-side", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, apply runs in the server instead of the client."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, include managed fields in the diff."},
+			},
+		},
+		{
+			name:        "drain",
+			description: "Drain node in preparation for maintenance",
+			options: []option{
+				{name: "chunk-size", valueType: "int64", defaultValue: "500", description: "Return large lists in chunks rather than all at once. Pass 0 to disable. This flag is beta and may change in the future."},
+				{name: "delete-emptydir-data", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Continue even if there are pods using emptyDir (local data that will be deleted when the node is drained)."},
+				{name: "delete-local-data", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "This option is deprecated and will be deleted. Use --delete-emptydir-data.", hidden: true, description: "Continue even if there are pods using emptyDir (local data that will be deleted when the node is drained)."},
+				{name: "disable-eviction", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "force", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Continue even if there are pods that do not declare a controller."},
+				{name: "grace-period", valueType: "int", defaultValue: "-1", description: "Period of time in seconds given to each pod to terminate gracefully. If negative, the default value specified in the pod will be used."},
+				{name: "ignore-daemonsets", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Ignore DaemonSet-managed pods."},
+				{name: "pod-selector", valueType: "string", description: "Label selector to filter pods on the node"},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "skip-wait-for-delete-timeout", valueType: "int", defaultValue: "0", description: "If pod DeletionTimestamp older than N seconds, skip waiting for the pod.  Seconds must be greater than 0 to skip."},
+				{name: "timeout", valueType: "duration", defaultValue: "0s", description: "The length of time to wait before giving up, zero means infinite"},
+			},
+		},
+		{
+			name:        "edit",
+			description: "Edit a resource on the server",
+			options: []option{
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-edit", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files to use to edit the resource"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "output-patch", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Output the patch if the resource is edited."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "subresource", valueType: "string", description: "If specified, edit will operate on the subresource of the requested object. Must be one of [status]. This flag is beta and may change in the future."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+				{name: "windows-line-endings", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Defaults to the line ending native to your platform."},
+			},
+		},
+		{
+			name:        "events",
+			description: "List events",
+			options: []option{
+				{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace."},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "chunk-size", valueType: "int64", defaultValue: "500", description: "Return large lists in chunks rather than all at once. Pass 0 to disable. This flag is beta and may change in the future."},
+				{name: "for", valueType: "string", description: "Filter events to only those pertaining to the specified resource."},
+				{name: "no-headers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When using the default output format, don't print headers."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "types", valueType: "stringSlice", defaultValue: "[]", description: "Output only events of given types."},
+				{name: "watch", shorthand: "w", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "After listing the requested events, watch for more events."},
+			},
+		},
+		{
+			name:        "exec",
+			description: "Execute a command in a container",
+			options: []option{
+				{name: "container", shorthand: "c", valueType: "string", description: "Container name. If omitted, use the kubectl.kubernetes.io/default-container annotation for selecting the container to be attached or the first container in the pod will be chosen"},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "to use to exec into the resource"},
+				{name: "pod-running-timeout", valueType: "duration", defaultValue: "1m0s", description: "The length of time (like 5s, 2m, or 3h, higher than zero) to wait until at least one pod is running"},
+				{name: "quiet", shorthand: "q", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Only print output from the remote session"},
+				{name: "stdin", shorthand: "i", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Pass stdin to the container"},
+				{name: "tty", shorthand: "t", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Stdin is a TTY"},
+			},
+		},
+		{
+			name:        "explain",
+			description: "Get documentation for a resource",
+			options: []option{
+				{name: "api-version", valueType: "string", description: "Use given api-version (group/version) of the resource."},
+				{name: "output", valueType: "string", defaultValue: "plaintext", description: "Format in which to render the schema. Valid values are: (plaintext, plaintext-openapiv2)."},
+				{name: "recursive", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When true, print the name of all the fields recursively. Otherwise, print the available fields with their description."},
+			},
+		},
+		{
+			name:        "expose",
+			description: "Take a replication controller, service, deployment or pod and expose it as a new Kubernetes service",
+			options: []option{
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "cluster-ip", valueType: "string", description: "ClusterIP to be assigned to the service. Leave empty to auto-allocate, or set to 'None' to create a headless service."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "external-ip", valueType: "string", description: "Additional external IP address (not managed by Kubernetes) to accept for the service. If this IP is routed to a node, the service can be accessed by this IP in addition to its generated service IP."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-expose", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to expose a service"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "labels", shorthand: "l", valueType: "string", description: "Labels to apply to the service created by this call."},
+				{name: "load-balancer-ip", valueType: "string", description: "IP to assign to the LoadBalancer. If empty, an ephemeral IP will be created and used (cloud-provider specific)."},
+				{name: "name", valueType: "string", description: "The name for the newly created object."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "override-type", valueType: "string", defaultValue: "merge", description: "The method used to override the generated object: json, merge, or strategic."},
+				{name: "overrides", valueType: "string", description: "An inline JSON override for the generated object. If this is non-empty, it is used to override the generated object. Requires that the object supply a valid apiVersion field."},
+				{name: "port", valueType: "string", description: "The port that the service should serve on. Copied from the resource being exposed, if unspecified"},
+				{name: "protocol", valueType: "string", description: "The network protocol for the service to be created. Default is 'TCP'."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+				{name: "selector", valueType: "string", description: "A label selector to use for this service. Only equality-based selector requirements are supported. If empty (the default) infer the selector from the replication controller or replica set.)"},
+				{name: "session-affinity", valueType: "string", description: "If non-empty, set the session affinity for the service to this; legal values: 'None', 'ClientIP'"},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "target-port", valueType: "string", description: "Name or number for the port on the container that the service should direct traffic to. Optional."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "type", valueType: "string", description: "Type for this service: ClusterIP, NodePort, LoadBalancer, or ExternalName. Default is 'ClusterIP'."},
+			},
+		},
+		{
+			name:        "get",
+			description: "Display one or many resources",
+			options: []option{
+				{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace."},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "chunk-size", valueType: "int64", defaultValue: "500", description: "Return large lists in chunks rather than all at once. Pass 0 to disable. This flag is beta and may change in the future."},
+				{name: "field-selector", valueType: "string", description: "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+				{name: "ignore-not-found", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If the requested object does not exist the command will return exit code 0."},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "label-columns", shorthand: "L", valueType: "stringSlice", defaultValue: "[]", description: "Accepts a comma separated list of labels that are going to be presented as columns. Names are case-sensitive. You can also use multiple flag options like -L label1 -L label2..."},
+				{name: "no-headers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When using the default or custom-column output format, don't print headers (default print headers)."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file, custom-columns, custom-columns-file, wide). See custom columns [https://kubernetes.io/docs/reference/kubectl/#custom-columns], golang template [http://golang.org/pkg/text/template/#pkg-overview] and jsonpath template [https://kubernetes.io/docs/reference/kubectl/jsonpath/]."},
+				{name: "output-watch-events", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Output watch event objects when --watch or --watch-only is used. Existing objects are output as initial ADDED events."},
+				{name: "raw", valueType: "string", description: "Raw URI to request from the server.  Uses the transport specified by the kubeconfig file."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "server-print", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, have the server return the appropriate table output. Supports extension APIs and CRDs."},
+				{name: "show-kind", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, list the resource type for the requested object(s)."},
+				{name: "show-labels", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "When printing, show all labels as the last column (default hide labels column)"},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "sort-by", valueType: "string", description: "If non-empty, sort list types using this field specification.  The field specification is expressed as a JSONPath expression (e.g. '{.metadata.name}'). The field in the API resource specified by this JSONPath expression must be an integer or a string."},
+				{name: "subresource", valueType: "string", description: "If specified, gets the subresource of the requested object. Must be one of [status scale]. This flag is beta and may change in the future."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "watch", shorthand: "w", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "After listing/getting the requested object, watch for changes."},
+				{name: "watch-only", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Watch for changes to the requested object(s), without listing/getting first."},
+			},
+		},
+		{
+			name:        "kustomize",
+			description: "Build a kustomization target from a directory or URL",
+			options: []option{
+				{name: "as-current-user", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "use the uid and gid of the command executor to run the function in the container"},
+				{name: "enable-alpha-plugins", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "enable kustomize plugins"},
+				{name: "enable-helm", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Enable use of the Helm chart inflator generator."},
+				{name: "enable-managedby-label", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "The flag `enable-managedby-label` has been deprecated. Use the `managedByLabel` option in the `buildMetadata` field instead.", hidden: true, description: "enable adding app.kubernetes.io/managed-by"},
+				{name: "env", shorthand: "e", valueType: "stringArray", defaultValue: "[]", description: "a list of environment variables to be used by functions"},
+				{name: "helm-command", valueType: "string", defaultValue: "helm", description: "helm command (path to executable)"},
+				{name: "load-restrictor", valueType: "string", defaultValue: "LoadRestrictionsRootOnly", description: "if set to 'LoadRestrictionsNone', local kustomizations may load files from outside their root. This does, however, break the relocatability of the kustomization."},
+				{name: "mount", valueType: "stringArray", defaultValue: "[]", description: "a list of storage options read from the filesystem"},
+				{name: "network", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "enable network access for functions that declare it"},
+				{name: "network-name", valueType: "string", defaultValue: "bridge", description: "the docker network to run the container in"},
+				{name: "output", shorthand: "o", valueType: "string", description: "If specified, write output to this path."},
+				{name: "reorder", valueType: "string", defaultValue: "legacy", deprecated: "use the new 'sortOptions' field in kustomization.yaml instead.", hidden: true, description: "Reorder the resources just before output. Use 'legacy' to apply a legacy reordering (Namespaces first, Webhooks last, etc). Use 'none' to suppress a final reordering."},
+			},
+		},
+		{
+			name:        "label",
+			description: "Update the labels on a resource",
+			options: []option{
+				{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources, in the namespace of the specified resource types"},
+				{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, check the specified action in all namespaces."},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-label", description: "Name of the manager used to track field ownership."},
+				{name: "field-selector", valueType: "string", description: "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to update the labels"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "list", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, display the labels for a given resource."},
+				{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, label will NOT contact api-server but run locally."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "overwrite", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, allow labels to be overwritten, otherwise reject label updates that overwrite existing labels."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "resource-version", valueType: "string", description: "If non-empty, the labels update will only succeed if this is the current resource-version for the object. Only valid when specifying a single resource."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+			},
+		},
+		{
+			name:        "logs",
+			description: "Print the logs for a container in a pod",
+			options: []option{
+				{name: "all-containers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Get all containers' logs in the pod(s)."},
+				{name: "container", shorthand: "c", valueType: "string", description: "Print the logs of this container"},
+				{name: "follow", shorthand: "f", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Specify if the logs should be streamed."},
+				{name: "ignore-errors", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If watching / following pod logs, allow for any errors that occur to be non-fatal"},
+				{name: "insecure-skip-tls-verify-backend", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Skip verifying the identity of the kubelet that logs are requested from.  In theory, an attacker could provide invalid log content back. You might want to use this if your kubelet serving certificates have expired."},
+				{name: "limit-bytes", valueType: "int64", defaultValue: "0", description: "Maximum bytes of logs to return. Defaults to no limit."},
+				{name: "max-log-requests", valueType: "int", defaultValue: "5", description: "Specify maximum number of concurrent logs to follow when using by a selector. Defaults to 5."},
+				{name: "pod-running-timeout", valueType: "duration", defaultValue: "20s", description: "The length of time (like 5s, 2m, or 3h, higher than zero) to wait until at least one pod is running"},
+				{name: "prefix", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Prefix each log line with the log source (pod name and container name)"},
+				{name: "previous", shorthand: "p", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, print the logs for the previous instance of the container in a pod if it exists."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "since", valueType: "duration", defaultValue: "0s", description: "Only return logs newer than a relative duration like 5s, 2m, or 3h. Defaults to all logs. Only one of since-time / since may be used."},
+				{name: "since-time", valueType: "string", description: "Only return logs after a specific date (RFC3339). Defaults to all logs. Only one of since-time / since may be used."},
+				{name: "tail", valueType: "int64", defaultValue: "-1", description: "Lines of recent log file to display. Defaults to -1 with no selector, showing all log lines otherwise 10, if a selector is provided."},
+				{name: "timestamps", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Include timestamps on each line in the log output"},
+			},
+		},
+		{
+			name:        "options",
+			description: "Print the list of flags inherited by all commands",
+			options:     []option{},
+		},
+		{
+			name:        "patch",
+			description: "Update fields of a resource",
+			options: []option{
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-patch", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to update"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, patch will operate on the content of the file, not the server-side resource."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "patch", shorthand: "p", valueType: "string", description: "The patch to be applied to the resource JSON file."},
+				{name: "patch-file", valueType: "string", description: "A file containing a patch to be applied to the resource."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "subresource", valueType: "string", description: "If specified, patch will operate on the subresource of the requested object. Must be one of [status scale]. This flag is beta and may change in the future."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "type", valueType: "string", defaultValue: "strategic", description: "The type of patch being provided; one of [json merge strategic]"},
+			},
+		},
+		{
+			name:        "plugin",
+			description: "Provides utilities for interacting with plugins",
+			options:     []option{},
+			subcommands: []command{
+				{
+					name:        "list",
+					description: "List all visible plugin executables on a user's PATH",
+					options: []option{
+						{name: "name-only", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, display only the binary name of each plugin, rather than its full path"},
+					},
+				},
+			},
+		},
+		{
+			name:        "port-forward",
+			description: "Forward one or more local ports to a pod",
+			options: []option{
+				{name: "address", valueType: "stringSlice", defaultValue: "[localhost]", description: "Addresses to listen on (comma separated). Only accepts IP addresses or localhost as a value. When localhost is supplied, kubectl will try to bind on both 127.0.0.1 and ::1 and will fail if neither of these addresses are available to bind."},
+				{name: "pod-running-timeout", valueType: "duration", defaultValue: "1m0s", description: "The length of time (like 5s, 2m, or 3h, higher than zero) to wait until at least one pod is running"},
+			},
+		},
+		{
+			name:        "proxy",
+			description: "Run a proxy to the Kubernetes API server",
+			options: []option{
+				{name: "accept-hosts", valueType: "string", defaultValue: "^localhost$,^127\\.0\\.0\\.1$,^\\[::1\\]$", description: "Regular expression for hosts that the proxy should accept."},
+				{name: "accept-paths", valueType: "string", defaultValue: "^.*", description: "Regular expression for paths that the proxy should accept."},
+				{name: "address", valueType: "string", defaultValue: "127.0.0.1", description: "The IP address on which to serve on."},
+				{name: "api-prefix", valueType: "string", defaultValue: "/", description: "Prefix to serve the proxied API under."},
+				{name: "append-server-path", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, enables automatic path appending of the kube context server path to each request."},
+				{name: "disable-filter", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, disable request filtering in the proxy. This is dangerous, and can leave you vulnerable to XSRF attacks, when used with an accessible port."},
+				{name: "keepalive", valueType: "duration", defaultValue: "0s", description: "keepalive specifies the keep-alive period for an active network connection. Set to 0 to disable keepalive."},
+				{name: "port", shorthand: "p", valueType: "int", defaultValue: "8001", description: "The port on which to run the proxy. Set to 0 to pick a random port."},
+				{name: "reject-methods", valueType: "string", defaultValue: "^$", description: "Regular expression for HTTP methods that the proxy should reject (example --reject-methods='POST,PUT,PATCH'). "},
+				{name: "reject-paths", valueType: "string", defaultValue: "^/api/.*/pods/.*/exec,^/api/.*/pods/.*/attach", description: "Regular expression for paths that the proxy should reject. Paths specified here will be rejected even accepted by --accept-paths."},
+				{name: "unix-socket", shorthand: "u", valueType: "string", description: "Unix socket on which to run the proxy."},
+				{name: "www", shorthand: "w", valueType: "string", description: "Also serve static files from the given directory under the specified prefix."},
+				{name: "www-prefix", shorthand: "P", valueType: "string", defaultValue: "/static/", description: "Prefix to serve static files under, if static file directory is specified."},
+			},
+		},
+		{
+			name:        "replace",
+			description: "Replace a resource by file name or stdin",
+			options: []option{
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "cascade", valueType: "string", defaultValue: "background", noOptDefault: "background", description: "Must be \"background\", \"orphan\", or \"foreground\". Selects the deletion cascading strategy for the dependents (e.g. Pods created by a ReplicationController). Defaults to background."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-replace", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "The files that contain the configurations to replace."},
+				{name: "force", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, immediately remove resources from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation."},
+				{name: "grace-period", valueType: "int", defaultValue: "-1", description: "Period of time in seconds given to the resource to terminate gracefully. Ignored if negative. Set to 1 for immediate shutdown. Can only be set to 0 when --force is true (force deletion)."},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process a kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "raw", valueType: "string", description: "Raw URI to PUT to the server.  Uses the transport specified by the kubeconfig file."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "subresource", valueType: "string", description: "If specified, replace will operate on the subresource of the requested object. Must be one of [status scale]. This flag is beta and may change in the future."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "timeout", valueType: "duration", defaultValue: "0s", description: "The length of time to wait before giving up on a delete, zero means determine a timeout from the size of the object"},
+				{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+				{name: "wait", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, wait for resources to be gone before returning. This waits for finalizers."},
+			},
+		},
+		{
+			name:        "rollout",
+			description: "Manage the rollout of a resource",
+			options:     []option{},
+			subcommands: []command{
+				{
+					name:        "history",
+					description: "View rollout history",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "revision", valueType: "int64", defaultValue: "0", description: "See the details, including podTemplate of the revision specified"},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "pause",
+					description: "Mark the provided resource as paused",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-rollout", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "restart",
+					description: "Restart a resource",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-rollout", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "resume",
+					description: "Resume a paused resource",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-rollout", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "status",
+					description: "Show the status of the rollout",
+					options: []option{
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "revision", valueType: "int64", defaultValue: "0", description: "Pin to a specific revision for showing its status. Defaults to 0 (last revision)."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "timeout", valueType: "duration", defaultValue: "0s", description: "The length of time to wait before ending watch, zero means never. Any other values should contain a corresponding time unit (e.g. 1s, 2m, 3h)."},
+						{name: "watch", shorthand: "w", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "Watch the status of the rollout until it's done."},
+					},
+				},
+				{
+					name:        "undo",
+					description: "Undo a previous rollout",
+					options: []option{
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "to-revision", valueType: "int64", defaultValue: "0", description: "The revision to rollback to. Default to 0 (last revision)."},
+					},
+				},
+			},
+		},
+		{
+			name:        "run",
+			description: "Run a particular image on the cluster",
+			options: []option{
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "annotations", valueType: "stringArray", defaultValue: "[]", description: "Annotations to apply to the pod."},
+				{name: "attach", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, wait for the Pod to start running, and then attach to the Pod as if 'kubectl attach ...' were called.  Default false, unless '-i/--stdin' is set, in which case the default is true. With '--restart=Never' the exit code of the container process is returned."},
+				{name: "cascade", valueType: "string", defaultValue: "background", noOptDefault: "background", deprecated: "because it is not relevant for this command. It will be removed in version 1.29.", hidden: true, description: "Must be \"background\", \"orphan\", or \"foreground\". Selects the deletion cascading strategy for the dependents (e.g. Pods created by a ReplicationController). Defaults to background."},
+				{name: "command", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true and extra arguments are present, use them as the 'command' field in the container, rather than the 'args' field which is the default."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "env", valueType: "stringArray", defaultValue: "[]", description: "Environment variables to set in the container."},
+				{name: "expose", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, create a ClusterIP service associated with the pod.  Requires `--port`."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-run", description: "Name of the manager used to track field ownership."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", deprecated: "because it is not used by this command. It will be removed in version 1.29.", hidden: true, description: "to use to replace the resource."},
+				{name: "force", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "because it is not used by this command. It will be removed in version 1.29.", hidden: true, description: "If true, immediately remove resources from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation."},
+				{name: "grace-period", valueType: "int", defaultValue: "-1", deprecated: "because it is not used by this command. It will be removed in version 1.29.", hidden: true, description: "Period of time in seconds given to the resource to terminate gracefully. Ignored if negative. Set to 1 for immediate shutdown. Can only be set to 0 when --force is true (force deletion)."},
+				{name: "image", valueType: "string", description: "The image for the container to run."},
+				{name: "image-pull-policy", valueType: "string", description: "The image pull policy for the container.  If left empty, this value will not be specified by the client and defaulted by the server."},
+				{name: "kustomize", shorthand: "k", valueType: "string", deprecated: "because it is not used by this command. It will be removed in version 1.29.", hidden: true, description: "Process a kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "labels", shorthand: "l", valueType: "string", description: "Comma separated labels to apply to the pod. Will override previous values."},
+				{name: "leave-stdin-open", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If the pod is started in interactive mode or with stdin, leave stdin open after the first attach completes. By default, stdin will be closed after the first attach completes."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "override-type", valueType: "string", defaultValue: "merge", description: "The method used to override the generated object: json, merge, or strategic."},
+				{name: "overrides", valueType: "string", description: "An inline JSON override for the generated object. If this is non-empty, it is used to override the generated object. Requires that the object supply a valid apiVersion field."},
+				{name: "pod-running-timeout", valueType: "duration", defaultValue: "1m0s", description: "The length of time (like 5s, 2m, or 3h, higher than zero) to wait until at least one pod is running"},
+				{name: "port", valueType: "string", description: "The port that this container exposes."},
+				{name: "privileged", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, run the container in privileged mode."},
+				{name: "quiet", shorthand: "q", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, suppress prompt messages."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "because it is not used by this command. It will be removed in version 1.29.", hidden: true, description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "restart", valueType: "string", defaultValue: "Always", description: "The restart policy for this Pod.  Legal values [Always, OnFailure, Never]."},
+				{name: "rm", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, delete the pod after it exits.  Only valid when attaching to the container, e.g. with '--attach' or with '-i/--stdin'."},
+				{name: "save-config", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, the configuration of current object will be saved in its annotation. Otherwise, the annotation will be unchanged. This flag is useful when you want to perform kubectl apply on this object in the future."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "stdin", shorthand: "i", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Keep stdin open on the container in the pod, even if nothing is attached."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "timeout", valueType: "duration", defaultValue: "0s", deprecated: "because it is not used by this command. It will be removed in version 1.29.", hidden: true, description: "The length of time to wait before giving up on a delete, zero means determine a timeout from the size of the object"},
+				{name: "tty", shorthand: "t", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Allocate a TTY for the container in the pod."},
+				{name: "wait", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "because it is not used by this command. It will be removed in version 1.29.", hidden: true, description: "If true, wait for resources to be gone before returning. This waits for finalizers."},
+			},
+		},
+		{
+			name:        "scale",
+			description: "Set a new size for a deployment, replica set, or replication controller",
+			options: []option{
+				{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources in the namespace of the specified resource types"},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "current-replicas", valueType: "int", defaultValue: "-1", description: "Precondition for current size. Requires that the current size of the resource match this value in order to scale. -1 (default) for no condition."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to set a new size"},
+				{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "replicas", valueType: "int", defaultValue: "0", description: "The new desired number of replicas. Required."},
+				{name: "resource-version", valueType: "string", description: "Precondition for resource version. Requires that the current resource version match this value in order to scale."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "timeout", valueType: "duration", defaultValue: "0s", description: "The length of time to wait before giving up on a scale operation, zero means don't wait. Any other values should contain a corresponding time unit (e.g. 1s, 2m, 3h)."},
+			},
+		},
+		{
+			name:        "set",
+			description: "Set specific features on objects",
+			options:     []option{},
+			subcommands: []command{
+				{
+					name:        "env",
+					description: "Update environment variables on a pod template",
+					options: []option{
+						{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, select all resources in the namespace of the specified resource types"},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "containers", shorthand: "c", valueType: "string", defaultValue: "*", description: "The names of containers in the selected pod templates to change - may use wildcards"},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "env", shorthand: "e", valueType: "stringArray", defaultValue: "[]", description: "Specify a key-value pair for an environment variable to set into each container."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-set", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files the resource to update the env"},
+						{name: "from", valueType: "string", description: "The name of a resource from which to inject environment variables"},
+						{name: "keys", valueType: "stringSlice", defaultValue: "[]", description: "Comma-separated list of keys to import from specified resource"},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "list", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, display the environment and any changes in the standard format. this flag will removed when we have kubectl view env."},
+						{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, set env will NOT contact api-server but run locally."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "overwrite", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, allow environment to be overwritten, otherwise reject updates that overwrite existing environment."},
+						{name: "prefix", valueType: "string", description: "Prefix to append to variable names"},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "resolve", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, show secret or configmap references when listing variables"},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "image",
+					description: "Update the image of a pod template",
+					options: []option{
+						{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources, in the namespace of the specified resource types"},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-set", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, set image will NOT contact api-server but run locally."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "resources",
+					description: "Update resource requests/limits on objects with pod templates",
+					options: []option{
+						{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources, in the namespace of the specified resource types"},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "containers", shorthand: "c", valueType: "string", defaultValue: "*", description: "The names of containers in the selected pod templates to change, all containers are selected by default - may use wildcards"},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-set", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "limits", valueType: "string", description: "The resource requirement requests for this container.  For example, 'cpu=100m,memory=256Mi'.  Note that server side components may assign requests depending on the server configuration, such as limit ranges."},
+						{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, set resources will NOT contact api-server but run locally."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "requests", valueType: "string", description: "The resource requirement requests for this container.  For example, 'cpu=100m,memory=256Mi'.  Note that server side components may assign requests depending on the server configuration, such as limit ranges."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "selector",
+					description: "Set the selector on a resource",
+					options: []option{
+						{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources in the namespace of the specified resource types"},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-set", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "identifying the resource."},
+						{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, annotation will NOT contact api-server but run locally."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "resource-version", valueType: "string", description: "If non-empty, the selectors update will only succeed if this is the current resource-version for the object. Only valid when specifying a single resource."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "serviceaccount",
+					aliases:     []string{"sa"},
+					description: "Update the service account of a resource",
+					options: []option{
+						{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources, in the namespace of the specified resource types"},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-set", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files identifying the resource to get from a server."},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, set serviceaccount will NOT contact api-server but run locally."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "record", valueType: "bool", defaultValue: "false", noOptDefault: "true", deprecated: "--record will be removed in the future", hidden: true, description: "Record current kubectl command in the resource annotation. If set to false, do not record the command. If set to true, record the command. If not set, default to updating the existing annotation value only if one already exists."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+					},
+				},
+				{
+					name:        "subject",
+					description: "Update the user, group, or service account in a role binding or cluster role binding",
+					options: []option{
+						{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources, in the namespace of the specified resource types"},
+						{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+						{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+						{name: "field-manager", valueType: "string", defaultValue: "kubectl-set", description: "Name of the manager used to track field ownership."},
+						{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "Filename, directory, or URL to files the resource to update the subjects"},
+						{name: "group", valueType: "stringArray", defaultValue: "[]", description: "Groups to bind to the role"},
+						{name: "kustomize", shorthand: "k", valueType: "string", description: "Process the kustomization directory. This flag can't be used together with -f or -R."},
+						{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, set subject will NOT contact api-server but run locally."},
+						{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+						{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "serviceaccount", valueType: "stringArray", defaultValue: "[]", description: "Service accounts to bind to the role"},
+						{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+						{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+						{name: "user", valueType: "stringArray", defaultValue: "[]", description: "Usernames to bind to the role"},
+					},
+				},
+			},
+		},
+		{
+			name:        "taint",
+			description: "Update the taints on one or more nodes",
+			options: []option{
+				{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all nodes in the cluster"},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "field-manager", valueType: "string", defaultValue: "kubectl-taint", description: "Name of the manager used to track field ownership."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "overwrite", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, allow taints to be overwritten, otherwise reject taint updates that overwrite existing taints."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "validate", valueType: "string", defaultValue: "strict", noOptDefault: "strict", description: "Must be one of: strict (or true), warn, ignore (or false).\n\t\t\"true\" or \"strict\" will use a schema to validate the input and fail the request if invalid. It will perform server side validation if ServerSideFieldValidation is enabled on the api-server, but will fall back to less reliable client-side validation if not.\n\t\t\"warn\" will warn about unknown or duplicate fields without blocking the request if server-side field validation is enabled on the API server, and behave as \"ignore\" otherwise.\n\t\t\"false\" or \"ignore\" will not perform any schema validation, silently dropping any unknown or duplicate fields."},
+			},
+		},
+		{
+			name:        "top",
+			description: "Display resource (CPU/memory) usage",
+			options:     []option{},
+			subcommands: []command{
+				{
+					name:        "node",
+					aliases:     []string{"nodes", "no"},
+					description: "Display resource (CPU/memory) usage of nodes",
+					options: []option{
+						{name: "no-headers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, print output without headers"},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "show-capacity", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Print node resources based on Capacity instead of Allocatable(default) of the nodes."},
+						{name: "sort-by", valueType: "string", description: "If non-empty, sort nodes list using specified field. The field can be either 'cpu' or 'memory'."},
+						{name: "use-protocol-buffers", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "Enables using protocol-buffers to access Metrics API."},
+					},
+				},
+				{
+					name:        "pod",
+					aliases:     []string{"pods", "po"},
+					description: "Display resource (CPU/memory) usage of pods",
+					options: []option{
+						{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace."},
+						{name: "containers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, print usage of containers within a pod."},
+						{name: "field-selector", valueType: "string", description: "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type."},
+						{name: "no-headers", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, print output without headers."},
+						{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+						{name: "sort-by", valueType: "string", description: "If non-empty, sort pods list using specified field. The field can be either 'cpu' or 'memory'."},
+						{name: "sum", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Print the sum of the resource usage"},
+						{name: "use-protocol-buffers", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "Enables using protocol-buffers to access Metrics API."},
+					},
+				},
+			},
+		},
+		{
+			name:        "uncordon",
+			description: "Mark node as schedulable",
+			options: []option{
+				{name: "dry-run", valueType: "string", defaultValue: "none", noOptDefault: "unchanged", description: "Must be \"none\", \"server\", or \"client\". If client strategy, only print the object that would be sent, without sending it. If server strategy, submit server-side request without persisting the resource."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints."},
+			},
+		},
+		{
+			name:        "version",
+			description: "Print the client and server version information",
+			options: []option{
+				{name: "client", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, shows client version only (no server required)."},
+				{name: "output", shorthand: "o", valueType: "string", description: "One of 'yaml' or 'json'."},
+			},
+		},
+		{
+			name:        "wait",
+			description: "Experimental: Wait for a specific condition on one or many resources",
+			options: []option{
+				{name: "all", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "Select all resources in the namespace of the specified resource types"},
+				{name: "all-namespaces", shorthand: "A", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If present, list the requested object(s) across all namespaces. Namespace in current context is ignored even if specified with --namespace."},
+				{name: "allow-missing-template-keys", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "If true, ignore any errors in templates when a field or map key is missing in the template. Only applies to golang and jsonpath output formats."},
+				{name: "field-selector", valueType: "string", description: "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2). The server only supports a limited number of field queries per type."},
+				{name: "filename", shorthand: "f", valueType: "stringSlice", defaultValue: "[]", description: "identifying the resource."},
+				{name: "for", valueType: "string", description: "The condition to wait on: [delete|condition=condition-name[=condition-value]|jsonpath='{JSONPath expression}'=[JSONPath value]]. The default condition-value is true.  Condition values are compared after Unicode simple case folding, which is a more general form of case-insensitivity."},
+				{name: "local", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, annotation will NOT contact api-server but run locally."},
+				{name: "output", shorthand: "o", valueType: "string", description: "Output format. One of: (json, yaml, name, go-template, go-template-file, template, templatefile, jsonpath, jsonpath-as-json, jsonpath-file)."},
+				{name: "recursive", shorthand: "R", valueType: "bool", defaultValue: "true", noOptDefault: "true", description: "Process the directory used in -f, --filename recursively. Useful when you want to manage related manifests organized within the same directory."},
+				{name: "selector", shorthand: "l", valueType: "string", description: "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)"},
+				{name: "show-managed-fields", valueType: "bool", defaultValue: "false", noOptDefault: "true", description: "If true, keep the managedFields when printing objects in JSON or YAML format."},
+				{name: "template", valueType: "string", description: "Template string or path to template file to use when -o=go-template, -o=go-template-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview]."},
+				{name: "timeout", valueType: "duration", defaultValue: "30s", description: "The length of time to wait before giving up.  Zero means check once and don't wait, negative means wait for a week."},
+			},
+		},
+	},
+}
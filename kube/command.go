@@ -0,0 +1,71 @@
+package kube
+
+import (
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+)
+
+// command is a kubectl command. kubectlCommand is generated from the cobra
+// command tree of kubectl by '_tools/codegen.sh'.
+type command struct {
+	name        string
+	aliases     []string
+	description string
+	deprecated  string
+	options     []option
+	subcommands []command
+}
+
+// globalOptions are the flags which can be passed to any command.
+var globalOptions = kubectlCommand.options
+
+func (c *command) subcommand(name string) (*command, bool) {
+	for i := range c.subcommands {
+		if c.subcommands[i].name == name {
+			return &c.subcommands[i], true
+		}
+		for _, alias := range c.subcommands[i].aliases {
+			if alias == name {
+				return &c.subcommands[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// lookupCommand resolves the longest command path of the positional arguments
+// like 'rollout history'. The first element is always kubectlCommand, and
+// the rest of the arguments are the positionals of the command.
+func lookupCommand(positionals []string) (path []*command, rest []string) {
+	path = []*command{&kubectlCommand}
+	for i := range positionals {
+		sub, ok := path[len(path)-1].subcommand(positionals[i])
+		if !ok {
+			return path, positionals[i:]
+		}
+		path = append(path, sub)
+	}
+	return path, nil
+}
+
+// commandSuggestions returns the subcommands of the command except deprecated ones.
+func commandSuggestions(c *command) []prompt.Suggest {
+	s := make([]prompt.Suggest, 0, len(c.subcommands))
+	for i := range c.subcommands {
+		if c.subcommands[i].deprecated != "" {
+			continue
+		}
+		s = append(s, prompt.Suggest{Text: c.subcommands[i].name, Description: c.subcommands[i].description})
+	}
+	return s
+}
+
+// commandName returns the command path without 'kubectl' like 'rollout history'.
+func commandName(path []*command) string {
+	names := make([]string, 0, len(path)-1)
+	for _, c := range path[1:] {
+		names = append(names, c.name)
+	}
+	return strings.Join(names, " ")
+}
@@ -3,85 +3,110 @@ package kube
 import (
 	"context"
 	"os"
+	"path"
 	"strings"
 
 	"github.com/c-bata/go-prompt"
 	"github.com/c-bata/go-prompt/completer"
+	"github.com/c-bata/kube-prompt/internal/debug"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
-func NewCompleter(ctx context.Context) (*Completer, error) {
-	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-		loadingRules,
-		&clientcmd.ConfigOverrides{},
-	)
-
-	config, err := loader.ClientConfig()
-	if err != nil {
-		return nil, err
-	}
+// Options are set by the command line flags of kube-prompt.
+type Options struct {
+	// Kubeconfig, Context and Namespace are the same as the flags of kubectl.
+	// They are passed to every kubectl command.
+	Kubeconfig string
+	Context    string
+	Namespace  string
+	// ReadOnly refuses kubectl commands which modify the cluster.
+	ReadOnly bool
+}
 
-	namespace, _, err := loader.Namespace()
-	if err != nil {
-		return nil, err
+func NewCompleter(ctx context.Context, opts Options) (*Completer, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = opts.Kubeconfig
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: opts.Context}
+	overrides.Context.Namespace = opts.Namespace
+	c := &Completer{
+		loadingRules: loadingRules,
+		overrides:    overrides,
+		clients:      make(map[string]*contextClient),
+		readOnly:     opts.ReadOnly,
 	}
-
-	client, err := kubernetes.NewForConfig(config)
+	detectKubectlVersion()
+	cc, err := c.newContextClient(ctx)
 	if err != nil {
 		return nil, err
 	}
+	c.use(cc)
+	return c, nil
+}
 
-	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
-	if err != nil {
-		if statusError, ok := err.(*errors.StatusError); ok && statusError.Status().Code == 403 {
-			namespaces = nil
-		} else {
-			return nil, err
-		}
-	}
+type Completer struct {
+	loadingRules *clientcmd.ClientConfigLoadingRules
+	overrides    *clientcmd.ConfigOverrides
+	// clients are kept per context so switching back to a context is instant.
+	clients map[string]*contextClient
+	// readOnly allows only commands which don't modify the cluster.
+	readOnly bool
 
-	return &Completer{
-		namespace:     namespace,
-		namespaceList: namespaces,
-		client:        client,
-	}, nil
+	context        string
+	namespace      string
+	namespaceList  *corev1.NamespaceList
+	client         kubernetes.Interface
+	metadataClient metadata.Interface
 }
 
-type Completer struct {
-	namespace     string
-	namespaceList *corev1.NamespaceList
-	client        *kubernetes.Clientset
+// discoveryClient returns nil if the clients of the context can't be built.
+// Completion which needs the cluster returns nothing then.
+func (c *Completer) discoveryClient() discovery.DiscoveryInterface {
+	if c.client == nil {
+		return nil
+	}
+	return c.client.Discovery()
 }
 
 func (c *Completer) Complete(d prompt.Document) []prompt.Suggest {
 	if d.TextBeforeCursor() == "" {
 		return []prompt.Suggest{}
 	}
-	args := strings.Split(d.TextBeforeCursor(), " ")
-	w := d.GetWordBeforeCursor()
-
-	// If PIPE is in text before the cursor, returns empty suggestions.
-	for i := range args {
-		if args[i] == "|" {
-			return []prompt.Suggest{}
-		}
+	line, ok := parseCommandLine(d.TextBeforeCursor())
+	if !ok {
+		// e.g. 'get pods | grep '
+		return []prompt.Suggest{}
 	}
-
-	// If word before the cursor starts with "-", returns CLI flag options.
-	if strings.HasPrefix(w, "-") {
-		return optionCompleter(args, strings.HasPrefix(w, "--"))
+	// e.g. '--context prod get pods '
+	lc, err := c.forLine(context.TODO(), line.args[:len(line.args)-1])
+	if err != nil {
+		debug.Log(err.Error())
+		return []prompt.Suggest{}
 	}
+	return lc.complete(d, line)
+}
+
+func (c *Completer) complete(d prompt.Document, line commandLine) []prompt.Suggest {
+	args := line.args
+	w := line.word.value
 
 	// Return suggestions for option
 	if suggests, found := c.completeOptionArguments(context.TODO(), d); found {
 		return suggests
 	}
 
+	// If word before the cursor starts with "-", returns CLI flag options.
+	if strings.HasPrefix(w, "-") {
+		if strings.Contains(w, "=") {
+			// the value of the flag like '-o=json'
+			return []prompt.Suggest{}
+		}
+		return optionCompleter(args, strings.HasPrefix(w, "--"))
+	}
+
 	namespace := checkNamespaceArg(d)
 	if namespace == "" {
 		namespace = c.namespace
@@ -89,25 +114,142 @@ func (c *Completer) Complete(d prompt.Document) []prompt.Suggest {
 	commandArgs, skipNext := excludeOptions(args)
 	if skipNext {
 		// when type 'get pod -o ', we don't want to complete pods. we want to type 'json' or other.
-		// So we need to skip argumentCompleter.
+		// So we need to skip argumentCompleter, but kubectl may know the values.
+		return line.replacements(c.getCobraSuggestions(namespace, args), "")
+	}
+	if len(commandArgs) == 0 {
 		return []prompt.Suggest{}
 	}
-	return c.argumentsCompleter(context.TODO(), namespace, commandArgs)
+	suggests := c.argumentsCompleter(context.TODO(), namespace, commandArgs)
+	if len(suggests) == 0 && !isBuiltinCommand(commandArgs[0]) {
+		// Commands not covered by argumentsCompleter like 'set image' or plugins.
+		suggests = c.getCobraSuggestions(namespace, args)
+	}
+	return line.replacements(suggests, "")
 }
 
-func checkNamespaceArg(d prompt.Document) string {
-	args := strings.Split(d.Text, " ")
-	var found bool
-	for i := 0; i < len(args); i++ {
-		if found {
-			return args[i]
+// isBuiltinCommand returns true for the commands of kube-prompt which kubectl does not know.
+func isBuiltinCommand(name string) bool {
+	switch name {
+	case "ns", "exit", "quit":
+		return true
+	}
+	return false
+}
+
+// wordSeparator must be the same as prompt.OptionCompletionWordSeparator in main.go.
+var wordSeparator = completer.FilePathCompletionSeparator
+
+// commandLine is the kubectl command under the cursor.
+type commandLine struct {
+	// args are the values of the words. The last one is the word under
+	// the cursor, which is empty after a blank.
+	args []string
+	word token
+	// text is the text before the cursor.
+	text string
+}
+
+// parseCommandLine returns the command which the cursor is in, like
+// 'get pods' in 'get nodes && kubectl get pods'. It returns false if the
+// command does not run kubectl, like 'grep' in 'get pods | grep'.
+func parseCommandLine(text string) (commandLine, bool) {
+	tokens := lex(text)
+	start := 0
+	for i := range tokens {
+		if tokens[i].kind == operatorToken {
+			start = i + 1
+		}
+	}
+	words := tokens[start:]
+	if len(words) == 0 || words[len(words)-1].end < len(text) {
+		words = append(words, token{kind: wordToken, start: len(text), end: len(text)})
+	}
+	if start > 0 {
+		// kube-prompt runs only the first command with kubectl.
+		if len(words) < 2 || path.Base(words[0].value) != "kubectl" {
+			return commandLine{}, false
+		}
+		words = words[1:]
+	}
+	return commandLine{
+		args: tokenValues(words),
+		word: words[len(words)-1],
+		text: text,
+	}, true
+}
+
+// replacements converts suggestions for the value of the word under the
+// cursor into the text which go-prompt replaces the word before the cursor
+// with. prefix is put before the value like '--namespace='. Suggestions
+// extending the value are appended to the text typed, so that quotes in the
+// middle of the word like "jsonpath='{.spec" are kept.
+func (l commandLine) replacements(suggests []prompt.Suggest, prefix string) []prompt.Suggest {
+	raw := l.text[l.word.start:]
+	value := strings.TrimPrefix(l.word.value, prefix)
+	w := raw[strings.LastIndexAny(raw, wordSeparator)+1:]
+	typed := raw[:len(raw)-len(w)]
+	quote := ""
+	if len(raw) > len(prefix) && (raw[len(prefix)] == '\'' || raw[len(prefix)] == '"') {
+		quote = raw[len(prefix) : len(prefix)+1]
+	}
+	if typed == "" && prefix == "" && raw == l.word.value {
+		return suggests
+	}
+
+	result := make([]prompt.Suggest, 0, len(suggests))
+	for _, s := range suggests {
+		text := prefix + quote + s.Text
+		if strings.HasPrefix(s.Text, value) {
+			text = raw + s.Text[len(value):]
 		}
-		if args[i] == "--namespace" || args[i] == "-n" {
-			found = true
+		if !strings.HasPrefix(text, typed) {
 			continue
 		}
+		s.Text = text[len(typed):]
+		result = append(result, s)
 	}
-	return ""
+	return result
+}
+
+// commandAt returns the arguments of the kubectl command which the position is in.
+func commandAt(text string, pos int) []string {
+	tokens := lex(text)
+	var command []token
+	for i := range tokens {
+		if tokens[i].start > pos {
+			break
+		}
+		if tokens[i].kind == operatorToken {
+			command = nil
+			continue
+		}
+		command = append(command, tokens[i])
+	}
+	for i := range tokens {
+		if tokens[i].start <= pos {
+			continue
+		}
+		if tokens[i].kind == operatorToken {
+			break
+		}
+		command = append(command, tokens[i])
+	}
+	args := tokenValues(command)
+	if len(tokens) > 0 && len(command) > 0 && command[0].start > tokens[0].start {
+		// a command after shell operators
+		if path.Base(args[0]) != "kubectl" {
+			return nil
+		}
+		args = args[1:]
+	}
+	return args
+}
+
+func checkNamespaceArg(d prompt.Document) string {
+	args := commandAt(d.Text, len(d.TextBeforeCursor()))
+	namespace, _ := flagValue(args, "-n", "--namespace")
+	return namespace
 }
 
 /* Option arguments */
@@ -126,122 +268,66 @@ var yamlFileCompleter = completer.FilePathCompleter{
 }
 
 func getPreviousOption(d prompt.Document) (cmd, option string, found bool) {
-	args := strings.Split(d.TextBeforeCursor(), " ")
-	l := len(args)
-	if l >= 2 {
-		option = args[l-2]
+	line, ok := parseCommandLine(d.TextBeforeCursor())
+	if !ok {
+		return "", "", false
 	}
-	if strings.HasPrefix(option, "-") {
-		return args[0], option, true
+	option, _, found = previousOption(line)
+	if !found {
+		return "", "", false
+	}
+	return line.args[0], option, true
+}
+
+// previousOption returns the flag whose value is under the cursor like
+// '-n kube-' or '--namespace=kube-'. prefix is the text before the value.
+func previousOption(line commandLine) (option, prefix string, found bool) {
+	args := line.args
+	if name, _, joined := strings.Cut(args[len(args)-1], "="); joined && strings.HasPrefix(name, "-") {
+		return name, name + "=", true
+	}
+	if len(args) >= 2 && strings.HasPrefix(args[len(args)-2], "-") && !strings.Contains(args[len(args)-2], "=") {
+		return args[len(args)-2], "", true
 	}
 	return "", "", false
 }
 
+// optionValue returns the value of the flag under the cursor like 'kube-'
+// in '--namespace=kube-'.
+func (l commandLine) optionValue() string {
+	_, prefix, _ := previousOption(l)
+	return strings.TrimPrefix(l.word.value, prefix)
+}
+
 func (c *Completer) completeOptionArguments(ctx context.Context, d prompt.Document) ([]prompt.Suggest, bool) {
-	cmd, option, found := getPreviousOption(d)
+	line, ok := parseCommandLine(d.TextBeforeCursor())
+	if !ok {
+		return []prompt.Suggest{}, false
+	}
+	flag, prefix, found := previousOption(line)
 	if !found {
 		return []prompt.Suggest{}, false
 	}
-
-	// namespace
-	if option == "-n" || option == "--namespace" {
-		return prompt.FilterHasPrefix(
-			getNameSpaceSuggestions(c.namespaceList),
-			d.GetWordBeforeCursor(),
-			true,
-		), true
+	commandArgs, _ := excludeOptions(line.args[:len(line.args)-1])
+	path, _ := lookupCommand(commandArgs)
+	o, ok := lookupOption(path, flag)
+	if !ok || (prefix == "" && !o.takesValue()) {
+		// boolean flags like '--watch' or '--dry-run' without '='
+		return []prompt.Suggest{}, false
 	}
 
 	// filename
-	switch cmd {
-	case "get", "describe", "create", "delete", "replace", "patch",
-		"edit", "apply", "expose", "rolling-update", "rollout",
-		"label", "annotate", "scale", "convert", "autoscale", "top":
-		if option == "-f" || option == "--filename" {
-			return yamlFileCompleter.Complete(d), true
-		}
-	}
-
-	// container
-	switch cmd {
-	case "exec", "logs", "run", "attach", "port-forward", "cp":
-		if option == "-c" || option == "--container" {
-			cmdArgs := getCommandArgs(d)
-			var suggestions []prompt.Suggest
-			if cmdArgs == nil || len(cmdArgs) < 2 {
-				suggestions = getContainerNamesFromCachedPods(ctx, c.client, c.namespace)
-			} else {
-				suggestions = getContainerName(ctx, c.client, c.namespace, cmdArgs[1])
-			}
-			return prompt.FilterHasPrefix(
-				suggestions,
-				d.GetWordBeforeCursor(),
-				true,
-			), true
-		}
-	}
-	return []prompt.Suggest{}, false
-}
-
-func getCommandArgs(d prompt.Document) []string {
-	args := strings.Split(d.TextBeforeCursor(), " ")
-
-	// If PIPE is in text before the cursor, returns empty.
-	for i := range args {
-		if args[i] == "|" {
-			return nil
-		}
+	if o.name == "filename" {
+		return yamlFileCompleter.Complete(d), true
 	}
 
-	commandArgs, _ := excludeOptions(args)
-	return commandArgs
-}
-
-func excludeOptions(args []string) ([]string, bool) {
-	l := len(args)
-	if l == 0 {
-		return nil, false
-	}
-	cmd := args[0]
-	filtered := make([]string, 0, l)
-
-	var skipNextArg bool
-	for i := 0; i < len(args); i++ {
-		if skipNextArg {
-			skipNextArg = false
-			continue
-		}
-
-		if cmd == "logs" && args[i] == "-f" {
-			continue
-		}
-
-		for _, s := range []string{
-			"-f", "--filename",
-			"-n", "--namespace",
-			"-s", "--server",
-			"--kubeconfig",
-			"--cluster",
-			"--user",
-			"-o", "--output",
-			"-c",
-			"--container",
-		} {
-			if strings.HasPrefix(args[i], s) {
-				if strings.Contains(args[i], "=") {
-					// we can specify option value like '-o=json'
-					skipNextArg = false
-				} else {
-					skipNextArg = true
-				}
-				continue
-			}
-		}
-		if strings.HasPrefix(args[i], "-") {
-			continue
-		}
-
-		filtered = append(filtered, args[i])
+	complete, ok := lookupOptionValueCompleter(path, o)
+	if !ok {
+		return []prompt.Suggest{}, false
 	}
-	return filtered, skipNextArg
+	return line.replacements(prompt.FilterHasPrefix(
+		complete(ctx, c, line),
+		line.optionValue(),
+		true,
+	), prefix), true
 }
@@ -0,0 +1,142 @@
+package kube
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+	"sigs.k8s.io/yaml"
+)
+
+const envConfigPath = "KUBE_PROMPT_CONFIG"
+
+// Config is the configuration of kube-prompt which is loaded from
+// $KUBE_PROMPT_CONFIG or $XDG_CONFIG_HOME/kube-prompt/config.yaml.
+//
+//	prefix:
+//	  colors:
+//	  - context: "*prod*"
+//	    color: red
+//	  - context: "*staging*"
+//	    color: yellow
+//	protection:
+//	  contexts: ["*prod*"]
+type Config struct {
+	Prefix     PrefixConfig     `json:"prefix"`
+	Protection ProtectionConfig `json:"protection"`
+}
+
+// PrefixConfig configures the prompt prefix.
+type PrefixConfig struct {
+	// Colors are checked in order and the first one matching the current context is used.
+	Colors []ContextColor `json:"colors"`
+}
+
+// ContextColor maps contexts matching the pattern to a color.
+type ContextColor struct {
+	// Context is a pattern of context names like '*prod*' (see path.Match).
+	Context string `json:"context"`
+	Color   string `json:"color"`
+}
+
+// ProtectionConfig asks for confirmation before running dangerous commands on protected contexts.
+type ProtectionConfig struct {
+	// Contexts are patterns of protected context names like '*prod*' (see path.Match).
+	Contexts []string `json:"contexts"`
+	// Commands are dangerous commands like 'delete' or 'scale --replicas=0'.
+	// A command matches if it starts with the words and has all the flags.
+	// defaultDangerousCommands is used if empty.
+	Commands []string `json:"commands"`
+}
+
+var colors = map[string]prompt.Color{
+	"default":   prompt.DefaultColor,
+	"black":     prompt.Black,
+	"darkred":   prompt.DarkRed,
+	"darkgreen": prompt.DarkGreen,
+	"brown":     prompt.Brown,
+	"darkblue":  prompt.DarkBlue,
+	"purple":    prompt.Purple,
+	"cyan":      prompt.Cyan,
+	"lightgray": prompt.LightGray,
+	"darkgray":  prompt.DarkGray,
+	"red":       prompt.Red,
+	"green":     prompt.Green,
+	"yellow":    prompt.Yellow,
+	"blue":      prompt.Blue,
+	"fuchsia":   prompt.Fuchsia,
+	"turquoise": prompt.Turquoise,
+	"white":     prompt.White,
+}
+
+func configPath() string {
+	if p := os.Getenv(envConfigPath); p != "" {
+		return p
+	}
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "kube-prompt", "config.yaml")
+}
+
+// LoadConfig reads the config file. It returns an empty config if the file does not exist.
+func LoadConfig() (*Config, error) {
+	config := &Config{}
+	p := configPath()
+	if p == "" {
+		return config, nil
+	}
+	b, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return config, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = yaml.UnmarshalStrict(b, config); err != nil {
+		return nil, fmt.Errorf("%s: %s", p, err)
+	}
+	if err = config.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %s", p, err)
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	for _, cc := range c.Prefix.Colors {
+		if _, err := path.Match(cc.Context, ""); err != nil {
+			return fmt.Errorf("invalid context pattern %q: %s", cc.Context, err)
+		}
+		if _, ok := colors[strings.ToLower(cc.Color)]; !ok {
+			return fmt.Errorf("unknown color %q", cc.Color)
+		}
+	}
+	for _, pattern := range c.Protection.Contexts {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid context pattern %q: %s", pattern, err)
+		}
+	}
+	for _, command := range c.Protection.Commands {
+		if len(parseCommandPattern(command).words) == 0 {
+			return fmt.Errorf("invalid dangerous command %q", command)
+		}
+	}
+	return nil
+}
+
+// prefixColor returns the color of the prompt prefix for the context.
+func (c *Config) prefixColor(contextName string) prompt.Color {
+	for _, cc := range c.Prefix.Colors {
+		if ok, _ := path.Match(cc.Context, contextName); ok {
+			return colors[strings.ToLower(cc.Color)]
+		}
+	}
+	return prompt.Blue
+}
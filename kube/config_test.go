@@ -0,0 +1,55 @@
+package kube
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/c-bata/go-prompt"
+)
+
+func TestLoadConfig(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv(envConfigPath, p)
+
+	config, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("Should not be error when the config file doesn't exist, but got %s", err)
+	}
+	if actual := config.prefixColor("prod-eu"); actual != prompt.Blue {
+		t.Errorf("Should be the default color, but got %d", actual)
+	}
+
+	err = os.WriteFile(p, []byte(`prefix:
+  colors:
+  - context: "*prod*"
+    color: red
+  - context: "*"
+    color: Green
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err = LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual := config.prefixColor("prod-eu"); actual != prompt.Red {
+		t.Errorf("Should be red, but got %d", actual)
+	}
+	if actual := config.prefixColor("staging"); actual != prompt.Green {
+		t.Errorf("Should be green, but got %d", actual)
+	}
+
+	err = os.WriteFile(p, []byte(`prefix:
+  colors:
+  - context: "*prod*"
+    color: crimson
+`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = LoadConfig(); err == nil {
+		t.Errorf("Should be error for unknown colors")
+	}
+}
@@ -0,0 +1,340 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/c-bata/kube-prompt/internal/debug"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// contextClient is a set of clients for a context in kubeconfig.
+type contextClient struct {
+	name          string
+	cluster       *clientcmdapi.Cluster
+	authInfo      *clientcmdapi.AuthInfo
+	namespace     string
+	namespaceList *corev1.NamespaceList
+	// sessionNamespace is set by 'ns' command. It is not written to kubeconfig.
+	sessionNamespace  string
+	previousNamespace string
+	client            kubernetes.Interface
+	metadataClient    metadata.Interface
+}
+
+func (c *Completer) clientConfig() clientcmd.ClientConfig {
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(c.loadingRules, c.overrides)
+}
+
+// currentContext reads kubeconfig and returns the name of the current
+// context with its cluster and user entries.
+func (c *Completer) currentContext() (string, *clientcmdapi.Cluster, *clientcmdapi.AuthInfo, error) {
+	rawConfig, err := c.clientConfig().RawConfig()
+	if err != nil {
+		return "", nil, nil, err
+	}
+	name := rawConfig.CurrentContext
+	if c.overrides.CurrentContext != "" {
+		name = c.overrides.CurrentContext
+	}
+	var clusterName, authInfoName string
+	if kubeContext, ok := rawConfig.Contexts[name]; ok {
+		clusterName, authInfoName = kubeContext.Cluster, kubeContext.AuthInfo
+	}
+	if c.overrides.Context.Cluster != "" {
+		clusterName = c.overrides.Context.Cluster
+	}
+	if c.overrides.Context.AuthInfo != "" {
+		authInfoName = c.overrides.Context.AuthInfo
+	}
+	return name, rawConfig.Clusters[clusterName], rawConfig.AuthInfos[authInfoName], nil
+}
+
+func (c *Completer) newContextClient(ctx context.Context) (*contextClient, error) {
+	loader := c.clientConfig()
+	name, cluster, authInfo, err := c.currentContext()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := loader.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, _, err := loader.Namespace()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataClient, err := metadata.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		// We don't have permission to list namespaces, or the cluster is not
+		// reachable now. Completion for the context is degraded, but kubectl
+		// may still work.
+		if statusError, ok := err.(*errors.StatusError); !ok || statusError.Status().Code != 403 {
+			debug.Log("failed to list namespaces: " + err.Error())
+		}
+		namespaces = nil
+	}
+
+	return &contextClient{
+		name:           name,
+		cluster:        cluster,
+		authInfo:       authInfo,
+		namespace:      namespace,
+		namespaceList:  namespaces,
+		client:         client,
+		metadataClient: metadataClient,
+	}, nil
+}
+
+func (c *Completer) use(cc *contextClient) {
+	c.clients[cc.name] = cc
+	c.context = cc.name
+	c.namespace = cc.namespace
+	if cc.sessionNamespace != "" {
+		c.namespace = cc.sessionNamespace
+	}
+	c.namespaceList = cc.namespaceList
+	c.client = cc.client
+	c.metadataClient = cc.metadataClient
+}
+
+// useUnavailable switches to the context whose clients can't be built.
+// Completion is disabled until the context is reloaded successfully.
+func (c *Completer) useUnavailable(name string) {
+	if name != c.context {
+		debug.Log("switch the context without clients: " + name)
+	}
+	namespace, _, err := c.clientConfig().Namespace()
+	if err != nil {
+		namespace = "default"
+	}
+	c.context = name
+	c.namespace = namespace
+	c.namespaceList = nil
+	c.client = nil
+	c.metadataClient = nil
+}
+
+// forgetContext drops the clients and caches of the context.
+func (c *Completer) forgetContext(name string) {
+	delete(c.clients, name)
+	objects.stop(name)
+	apiResourceList.Delete(name)
+	lastFetchedAt.Delete("api_resources_" + name)
+	apiVersionList.Delete(name)
+	lastFetchedAt.Delete("api_versions_" + name)
+	for _, m := range []*sync.Map{openAPISchemaList, fullObjectList, podList} {
+		m.Range(func(key, _ interface{}) bool {
+			if strings.HasPrefix(key.(string), name+"/") {
+				m.Delete(key)
+			}
+			return true
+		})
+	}
+}
+
+// reloadContextTimeout limits building the clients after each command so that
+// an unreachable cluster doesn't block the prompt.
+const reloadContextTimeout = 3 * time.Second
+
+// reloadContext checks kubeconfig and switches the clients if the current
+// context, or the cluster or user of it, has been changed.
+func (c *Completer) reloadContext(ctx context.Context) error {
+	name, cluster, authInfo, err := c.currentContext()
+	if err != nil {
+		return err
+	}
+
+	cc, ok := c.clients[name]
+	if ok && (!reflect.DeepEqual(cc.cluster, cluster) || !reflect.DeepEqual(cc.authInfo, authInfo)) {
+		debug.Log("kubeconfig is changed: " + name)
+		c.forgetContext(name)
+		ok = false
+	}
+	if !ok {
+		cc, err = c.newContextClient(ctx)
+		if err != nil {
+			// kubectl targets the context anyway, so we follow it without completion.
+			c.useUnavailable(name)
+			return err
+		}
+	} else {
+		// The default namespace of the context may be changed by 'config set-context'.
+		if namespace, _, err := c.clientConfig().Namespace(); err == nil {
+			cc.namespace = namespace
+		} else {
+			debug.Log(err.Error())
+		}
+	}
+	if name != c.context {
+		debug.Log("switch the context: " + name)
+	}
+	c.use(cc)
+	return nil
+}
+
+/* Connection overrides */
+
+// lineClientTimeout limits building the clients for the flags typed on the line.
+const lineClientTimeout = 3 * time.Second
+
+// connectionOverrides are the flags typed on the line which change the
+// cluster kubectl connects to like '--context prod'.
+type connectionOverrides struct {
+	kubeconfig string
+	context    string
+	cluster    string
+	user       string
+}
+
+func lineConnectionOverrides(args []string) connectionOverrides {
+	var o connectionOverrides
+	o.kubeconfig, _ = flagValue(args, "--kubeconfig")
+	o.context, _ = flagValue(args, "--context")
+	o.cluster, _ = flagValue(args, "--cluster")
+	o.user, _ = flagValue(args, "--user")
+	return o
+}
+
+// key identifies the clients and the caches for the overrides like 'prod'
+// or 'prod,cluster=staging'. It is the context name without other flags,
+// so that the clients are shared with the context.
+func (o connectionOverrides) key(contextName string) string {
+	key := contextName
+	if o.cluster != "" {
+		key += ",cluster=" + o.cluster
+	}
+	if o.user != "" {
+		key += ",user=" + o.user
+	}
+	if o.kubeconfig != "" {
+		key += ",kubeconfig=" + o.kubeconfig
+	}
+	return key
+}
+
+// withOverrides returns a copy of the Completer which reads kubeconfig with the overrides.
+func (c *Completer) withOverrides(o connectionOverrides) *Completer {
+	loadingRules := *c.loadingRules
+	if o.kubeconfig != "" {
+		loadingRules.ExplicitPath = o.kubeconfig
+	}
+	overrides := *c.overrides
+	if o.context != "" {
+		overrides.CurrentContext = o.context
+	}
+	if o.cluster != "" {
+		overrides.Context.Cluster = o.cluster
+	}
+	if o.user != "" {
+		overrides.Context.AuthInfo = o.user
+	}
+	lc := *c
+	lc.loadingRules, lc.overrides = &loadingRules, &overrides
+	return &lc
+}
+
+// lineContextName returns the name of the context which the arguments target
+// like 'prod' for '--context prod delete ns foo'.
+func (c *Completer) lineContextName(args []string) (string, error) {
+	o := lineConnectionOverrides(args)
+	if o == (connectionOverrides{}) {
+		return c.context, nil
+	}
+	name, _, _, err := c.withOverrides(o).currentContext()
+	return name, err
+}
+
+// forLine returns the Completer for the connection flags typed in the
+// arguments like '--context prod get pods'. The clients for them are built
+// lazily and kept with their caches like the ones of other contexts.
+func (c *Completer) forLine(ctx context.Context, args []string) (*Completer, error) {
+	o := lineConnectionOverrides(args)
+	if o == (connectionOverrides{}) {
+		return c, nil
+	}
+	lc := c.withOverrides(o)
+	name, _, _, err := lc.currentContext()
+	if err != nil {
+		return nil, err
+	}
+	key := o.key(name)
+	if key == c.context {
+		return c, nil
+	}
+	cc, ok := c.clients[key]
+	if !ok {
+		if !shouldFetch("context_client_" + key) {
+			return nil, fmt.Errorf("failed to connect recently: %s", key)
+		}
+		updateLastFetchedAt("context_client_" + key)
+		ctx, cancel := context.WithTimeout(ctx, lineClientTimeout)
+		defer cancel()
+		if cc, err = lc.newContextClient(ctx); err != nil {
+			return nil, err
+		}
+		cc.name = key
+	}
+	lc.use(cc)
+	return lc, nil
+}
+
+/* Kubeconfig */
+
+// getKubeconfigSuggestions returns the names of contexts, clusters or users
+// in the merged kubeconfig.
+func (c *Completer) getKubeconfigSuggestions(kind string) []prompt.Suggest {
+	rawConfig, err := c.clientConfig().RawConfig()
+	if err != nil {
+		debug.Log(err.Error())
+		return []prompt.Suggest{}
+	}
+	var suggests []prompt.Suggest
+	switch kind {
+	case "context":
+		for name, kubeContext := range rawConfig.Contexts {
+			description := kubeContext.Cluster
+			if name == rawConfig.CurrentContext {
+				description += " (current)"
+			}
+			suggests = append(suggests, prompt.Suggest{Text: name, Description: description})
+		}
+	case "cluster":
+		for name, cluster := range rawConfig.Clusters {
+			suggests = append(suggests, prompt.Suggest{Text: name, Description: cluster.Server})
+		}
+	case "user":
+		for name := range rawConfig.AuthInfos {
+			suggests = append(suggests, prompt.Suggest{Text: name})
+		}
+	}
+	sort.Slice(suggests, func(i, j int) bool {
+		return suggests[i].Text < suggests[j].Text
+	})
+	return suggests
+}
@@ -0,0 +1,196 @@
+package kube
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/c-bata/go-prompt"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func writeTestKubeconfig(t *testing.T, path, server, currentContext string) {
+	t.Helper()
+	config := clientcmdapi.NewConfig()
+	config.Clusters["cluster"] = &clientcmdapi.Cluster{Server: server}
+	config.AuthInfos["user"] = &clientcmdapi.AuthInfo{Token: "token"}
+	config.Contexts["staging"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user", Namespace: "web"}
+	config.Contexts["production"] = &clientcmdapi.Context{Cluster: "cluster", AuthInfo: "user"}
+	config.CurrentContext = currentContext
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReloadContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"NamespaceList","apiVersion":"v1","items":[]}`))
+	}))
+	defer server.Close()
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	writeTestKubeconfig(t, kubeconfig, server.URL, "staging")
+
+	c := &Completer{
+		loadingRules: &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		overrides:    &clientcmd.ConfigOverrides{},
+		clients:      make(map[string]*contextClient),
+	}
+	if err := c.reloadContext(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if c.context != "staging" || c.namespace != "web" {
+		t.Errorf("Should be staging/web, but got %s/%s", c.context, c.namespace)
+	}
+	staging := c.client
+
+	writeTestKubeconfig(t, kubeconfig, server.URL, "production")
+	if err := c.reloadContext(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if c.context != "production" || c.namespace != "default" {
+		t.Errorf("Should be production/default, but got %s/%s", c.context, c.namespace)
+	}
+
+	writeTestKubeconfig(t, kubeconfig, server.URL, "staging")
+	if err := c.reloadContext(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if c.client != staging {
+		t.Errorf("The client of staging should be reused")
+	}
+
+	// Changing the cluster of the context re-creates the client.
+	writeTestKubeconfig(t, kubeconfig, server.URL+"/", "staging")
+	if err := c.reloadContext(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if c.client == staging {
+		t.Errorf("The client of staging should be re-created")
+	}
+
+	// The context is switched even if the cluster rejects the token.
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer unauthorized.Close()
+	writeTestKubeconfig(t, kubeconfig, unauthorized.URL, "production")
+	if err := c.reloadContext(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if c.context != "production" || c.namespaceList != nil {
+		t.Errorf("Should be production without namespaces, but got %s %v", c.context, c.namespaceList)
+	}
+
+	// The context is switched without completion if its clients can't be built.
+	writeTestKubeconfig(t, kubeconfig, server.URL, "unknown")
+	if err := c.reloadContext(context.TODO()); err == nil {
+		t.Errorf("Should be failed for the unknown context")
+	}
+	if c.context != "unknown" || c.client != nil {
+		t.Errorf("Should be switched to unknown without clients, but got %s", c.context)
+	}
+	complete := func(text string) []prompt.Suggest {
+		buf := prompt.NewBuffer()
+		buf.InsertText(text, false, true)
+		return c.Complete(*buf.Document())
+	}
+	if actual := complete("get pods "); len(actual) != 0 {
+		t.Errorf("Should not complete objects without clients, but got %v", actual)
+	}
+	// Commands and flags don't need the cluster.
+	if actual := complete("rollout his"); len(actual) != 1 || actual[0].Text != "history" {
+		t.Errorf("Should complete subcommands without clients, but got %v", actual)
+	}
+	if actual := complete("get pods --all-n"); len(actual) != 1 || actual[0].Text != "--all-namespaces" {
+		t.Errorf("Should complete flags without clients, but got %v", actual)
+	}
+}
+
+func TestForLine(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"NamespaceList","apiVersion":"v1","items":[]}`))
+	}))
+	defer server.Close()
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	writeTestKubeconfig(t, kubeconfig, server.URL, "staging")
+
+	c := &Completer{
+		loadingRules: &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		overrides:    &clientcmd.ConfigOverrides{},
+		clients:      make(map[string]*contextClient),
+	}
+	if err := c.reloadContext(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+
+	var scenarioTable = []struct {
+		args      []string
+		context   string
+		namespace string
+	}{
+		{args: []string{"get", "pods"}, context: "staging", namespace: "web"},
+		{args: []string{"--context", "staging", "get", "pods"}, context: "staging", namespace: "web"},
+		{args: []string{"--context=production", "get", "pods"}, context: "production", namespace: "default"},
+		{args: []string{"get", "pods", "--user", "user"}, context: "staging,user=user", namespace: "web"},
+	}
+
+	for _, s := range scenarioTable {
+		lc, err := c.forLine(context.TODO(), s.args)
+		if err != nil {
+			t.Errorf("%q: should not be failed, but got %s", s.args, err)
+			continue
+		}
+		if lc.context != s.context || lc.namespace != s.namespace {
+			t.Errorf("%q: should be %s/%s, but got %s/%s", s.args, s.context, s.namespace, lc.context, lc.namespace)
+		}
+	}
+	if c.context != "staging" {
+		t.Errorf("The context of the session should not be changed, but got %s", c.context)
+	}
+	if _, err := c.forLine(context.TODO(), []string{"--context", "unknown", "get", "pods"}); err == nil {
+		t.Errorf("Should be failed for the unknown context")
+	}
+
+	// kubectl runs in the session namespace of the context typed on the line.
+	c.clients["staging"].sessionNamespace = "db"
+	c.namespace = "db"
+	e := NewExecutor(c, &Config{})
+	var namespaceTable = []struct {
+		input     string
+		namespace string
+		expected  string
+	}{
+		{input: "--context staging get cm", namespace: "db", expected: " --namespace 'db'"},
+		{input: "--context production get cm", namespace: "default", expected: ""},
+	}
+	for _, s := range namespaceTable {
+		lc, err := c.forLine(context.TODO(), strings.Fields(s.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lc.namespace != s.namespace {
+			t.Errorf("%q: should complete in %s, but got %s", s.input, s.namespace, lc.namespace)
+		}
+		expected := "kubectl " + s.input + " --kubeconfig '" + kubeconfig + "'" + s.expected
+		if actual, _ := e.shellCommand(s.input); actual != expected {
+			t.Errorf("%q: should be %q, but got %q", s.input, expected, actual)
+		}
+	}
+
+	expected := []prompt.Suggest{
+		{Text: "production", Description: "cluster"},
+		{Text: "staging", Description: "cluster (current)"},
+	}
+	if actual := c.getKubeconfigSuggestions("context"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Should be %v, but got %v", expected, actual)
+	}
+}
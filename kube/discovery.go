@@ -0,0 +1,205 @@
+package kube
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/c-bata/kube-prompt/internal/debug"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+const thresholdDiscoveryInterval = 5 * time.Minute
+
+func init() {
+	apiResourceList = new(sync.Map)
+}
+
+// apiResource is a resource type served by the API server.
+type apiResource struct {
+	gvr        schema.GroupVersionResource
+	kind       string
+	namespaced bool
+	singular   string
+	shortNames []string
+}
+
+// names returns every name kubectl accepts for this resource type.
+func (r apiResource) names() []string {
+	names := []string{r.gvr.Resource}
+	if r.singular != "" && r.singular != r.gvr.Resource {
+		names = append(names, r.singular)
+	}
+	names = append(names, r.shortNames...)
+	if r.gvr.Group != "" {
+		names = append(names, r.gvr.Resource+"."+r.gvr.Group)
+	}
+	return names
+}
+
+/* API Resources */
+
+var (
+	apiResourceList *sync.Map
+)
+
+func fetchAPIResources(client discovery.DiscoveryInterface, contextName string) {
+	if client == nil {
+		return
+	}
+	key := "api_resources_" + contextName
+	if !shouldFetchWithInterval(key, thresholdDiscoveryInterval) {
+		return
+	}
+	updateLastFetchedAt(key)
+
+	lists, err := client.ServerPreferredResources()
+	if err != nil {
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			debug.Log(err.Error())
+			return
+		}
+		// Some aggregated APIs may be unavailable. Use what we could discover.
+		debug.Log(err.Error())
+	}
+
+	resources := make([]apiResource, 0, len(lists)*8)
+	for _, l := range lists {
+		gv, err := schema.ParseGroupVersion(l.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range l.APIResources {
+			if strings.Contains(r.Name, "/") {
+				// skip subresources like 'pods/log'
+				continue
+			}
+			resources = append(resources, apiResource{
+				gvr:        gv.WithResource(r.Name),
+				kind:       r.Kind,
+				namespaced: r.Namespaced,
+				singular:   r.SingularName,
+				shortNames: r.ShortNames,
+			})
+		}
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		// Prefer the core group when the same plural name is served by
+		// multiple groups (e.g. 'events' and 'events.events.k8s.io').
+		if resources[i].gvr.Resource == resources[j].gvr.Resource {
+			return resources[i].gvr.Group == ""
+		}
+		return resources[i].gvr.Resource < resources[j].gvr.Resource
+	})
+	apiResourceList.Store(contextName, resources)
+}
+
+func getAPIResources(client discovery.DiscoveryInterface, contextName string) []apiResource {
+	go fetchAPIResources(client, contextName)
+	x, ok := apiResourceList.Load(contextName)
+	if !ok {
+		return nil
+	}
+	l, ok := x.([]apiResource)
+	if !ok {
+		return nil
+	}
+	return l
+}
+
+func getResourceTypeSuggestions(client discovery.DiscoveryInterface, contextName string) []prompt.Suggest {
+	l := getAPIResources(client, contextName)
+	if len(l) == 0 {
+		// Discovery is not finished yet.
+		return resourceTypes
+	}
+
+	seen := make(map[string]struct{}, len(l)*4)
+	plurals := make([]prompt.Suggest, 0, len(l))
+	aliases := make([]prompt.Suggest, 0, len(l)*3)
+	for i := range l {
+		description := l[i].kind
+		if gv := l[i].gvr.GroupVersion().String(); gv != "" {
+			description += " (" + gv + ")"
+		}
+		for j, name := range l[i].names() {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			s := prompt.Suggest{Text: name, Description: description}
+			if j == 0 {
+				plurals = append(plurals, s)
+			} else {
+				aliases = append(aliases, s)
+			}
+		}
+	}
+	return append(plurals, aliases...)
+}
+
+// lookupAPIResource resolves a resource type typed by users such as 'po',
+// 'deployment', 'deployments.apps' or 'deployments.v1.apps' through discovery.
+func lookupAPIResource(client discovery.DiscoveryInterface, contextName string, name string) (apiResource, bool) {
+	name = strings.ToLower(name)
+	l := getAPIResources(client, contextName)
+	for i := range l {
+		if strings.ToLower(l[i].kind) == name {
+			return l[i], true
+		}
+		for _, n := range l[i].names() {
+			if n == name {
+				return l[i], true
+			}
+		}
+		if l[i].gvr.Resource+"."+l[i].gvr.Version+"."+l[i].gvr.Group == name {
+			return l[i], true
+		}
+	}
+	return apiResource{}, false
+}
+
+/* API versions */
+
+var apiVersionList = new(sync.Map)
+
+func fetchAPIVersions(client discovery.DiscoveryInterface, contextName string) {
+	if client == nil {
+		return
+	}
+	key := "api_versions_" + contextName
+	if !shouldFetchWithInterval(key, thresholdDiscoveryInterval) {
+		return
+	}
+	updateLastFetchedAt(key)
+
+	groups, err := client.ServerGroups()
+	if err != nil {
+		debug.Log(err.Error())
+		return
+	}
+	suggests := make([]prompt.Suggest, 0, len(groups.Groups)*2)
+	for _, g := range groups.Groups {
+		for _, v := range g.Versions {
+			s := prompt.Suggest{Text: v.GroupVersion}
+			if v.GroupVersion == g.PreferredVersion.GroupVersion {
+				s.Description = "preferred"
+			}
+			suggests = append(suggests, s)
+		}
+	}
+	apiVersionList.Store(contextName, suggests)
+}
+
+// getAPIVersionSuggestions returns the group versions served by the cluster like 'apps/v1'.
+func getAPIVersionSuggestions(client discovery.DiscoveryInterface, contextName string) []prompt.Suggest {
+	go fetchAPIVersions(client, contextName)
+	x, ok := apiVersionList.Load(contextName)
+	if !ok {
+		return []prompt.Suggest{}
+	}
+	return x.([]prompt.Suggest)
+}
@@ -0,0 +1,93 @@
+package kube
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+type preferredResourcesDiscovery struct {
+	*fakediscovery.FakeDiscovery
+}
+
+func (d preferredResourcesDiscovery) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	return d.Resources, nil
+}
+
+func newTestDiscovery() preferredResourcesDiscovery {
+	return preferredResourcesDiscovery{&fakediscovery.FakeDiscovery{Fake: &clienttesting.Fake{
+		Resources: []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+				APIResources: []metav1.APIResource{
+					{Name: "pods", SingularName: "pod", Kind: "Pod", Namespaced: true, ShortNames: []string{"po"}},
+					{Name: "pods/log", Kind: "Pod", Namespaced: true},
+					{Name: "events", SingularName: "event", Kind: "Event", Namespaced: true, ShortNames: []string{"ev"}},
+				},
+			},
+			{
+				GroupVersion: "events.k8s.io/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "events", SingularName: "event", Kind: "Event", Namespaced: true, ShortNames: []string{"ev"}},
+				},
+			},
+			{
+				GroupVersion: "cert-manager.io/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "certificates", SingularName: "certificate", Kind: "Certificate", Namespaced: true, ShortNames: []string{"cert", "certs"}},
+				},
+			},
+		},
+	}}}
+}
+
+func TestLookupAPIResource(t *testing.T) {
+	client := newTestDiscovery()
+	fetchAPIResources(client, "test-lookup")
+
+	var scenarioTable = []struct {
+		name     string
+		expected string
+		found    bool
+	}{
+		{name: "po", expected: "/v1, Resource=pods", found: true},
+		{name: "Pod", expected: "/v1, Resource=pods", found: true},
+		{name: "events", expected: "/v1, Resource=events", found: true},
+		{name: "events.events.k8s.io", expected: "events.k8s.io/v1, Resource=events", found: true},
+		{name: "certs", expected: "cert-manager.io/v1, Resource=certificates", found: true},
+		{name: "certificates.v1.cert-manager.io", expected: "cert-manager.io/v1, Resource=certificates", found: true},
+		{name: "pods/log", found: false},
+		{name: "unknown", found: false},
+	}
+	for _, s := range scenarioTable {
+		r, found := lookupAPIResource(client, "test-lookup", s.name)
+		if found != s.found {
+			t.Errorf("%s: should be found=%v, but got %v", s.name, s.found, found)
+			continue
+		}
+		if found && r.gvr.String() != s.expected {
+			t.Errorf("%s: should be %s, but got %s", s.name, s.expected, r.gvr.String())
+		}
+	}
+}
+
+func TestGetResourceTypeSuggestions(t *testing.T) {
+	client := newTestDiscovery()
+	fetchAPIResources(client, "test-suggestions")
+
+	suggests := getResourceTypeSuggestions(client, "test-suggestions")
+	texts := make(map[string]int, len(suggests))
+	for _, s := range suggests {
+		texts[s.Text]++
+	}
+	for _, expected := range []string{"pods", "pod", "po", "events", "events.events.k8s.io", "certificates.cert-manager.io", "certs"} {
+		if texts[expected] != 1 {
+			t.Errorf("%s should be suggested exactly once, but got %d", expected, texts[expected])
+		}
+	}
+	if _, ok := texts["pods/log"]; ok {
+		t.Errorf("subresources should not be suggested")
+	}
+}
@@ -1,16 +1,34 @@
 package kube
 
 import (
-	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path"
 	"strings"
 
 	"github.com/c-bata/kube-prompt/internal/debug"
 )
 
-func Executor(s string) {
+// Executor runs kubectl commands and keeps the Completer pointed at
+// the same context as kubectl.
+type Executor struct {
+	completer *Completer
+	config    *Config
+	stdin     io.Reader
+}
+
+func NewExecutor(c *Completer, config *Config) *Executor {
+	return &Executor{
+		completer: c,
+		config:    config,
+		stdin:     os.Stdin,
+	}
+}
+
+func (e *Executor) Execute(s string) {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return
@@ -18,33 +36,209 @@ func Executor(s string) {
 		fmt.Println("Bye!")
 		os.Exit(0)
 		return
+	} else if s == "ns" || strings.HasPrefix(s, "ns ") {
+		e.executeNamespaceCommand(strings.Fields(s))
+		return
 	}
 
-	cmd := exec.Command("/bin/sh", "-c", "kubectl "+s)
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
+	if e.completer.readOnly {
+		if reason, ok := readOnlyViolation(s); ok {
+			fmt.Printf("Refused in read-only mode: %s\n", reason)
+			return
+		}
+	}
+	if !e.confirm(s) {
+		fmt.Println("Canceled.")
+		return
+	}
+
+	var err error
+	if e.completer.readOnly {
+		err = e.runPipeline(s)
+	} else {
+		line, ok := e.shellCommand(s)
+		if !ok {
+			fmt.Println("Warning: kubectl in scripts like 'sh -c' runs without the flags and the namespace of kube-prompt.")
+		}
+		cmd := exec.Command("/bin/sh", "-c", line)
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err = cmd.Run()
+	}
+	if err != nil {
 		fmt.Printf("Got error: %s\n", err.Error())
 	}
+
+	// The command may switch the context like 'config use-context'.
+	ctx, cancel := context.WithTimeout(context.Background(), reloadContextTimeout)
+	defer cancel()
+	if err := e.completer.reloadContext(ctx); err != nil {
+		debug.Log(err.Error())
+		fmt.Printf("Failed to reload kubeconfig: %s\n", err.Error())
+	}
 	return
 }
 
-func ExecuteAndGetResult(s string) string {
-	s = strings.TrimSpace(s)
-	if s == "" {
-		debug.Log("you need to pass the something arguments")
-		return ""
+// shellCommand returns the shell command line which runs s with kubectl.
+// The flags of kube-prompt and the session namespace are added to every
+// kubectl command in the line like 'get pods && kubectl get svc'.
+// It returns false if kubectl may be run in scripts which can't be rewritten.
+func (e *Executor) shellCommand(s string) (string, bool) {
+	flags := e.completer.kubectlFlags()
+	ok := true
+	var b strings.Builder
+	b.WriteString("kubectl ")
+	last := 0
+	for i, command := range splitCommands(lex(s)) {
+		words := tokenValues(command)
+		// kube-prompt runs the first command with kubectl.
+		j, end := -1, 0
+		if i > 0 {
+			if j = kubectlCommandIndex(words); j >= 0 {
+				end = command[j].end
+			}
+		}
+		if i == 0 || j >= 0 {
+			if extra := flags + e.namespaceFlag(words[j+1:]); extra != "" {
+				at, text := flagsInsertion(command[j+1:], end, extra)
+				b.WriteString(s[last:at] + text)
+				last = at
+			}
+		}
+		for k := range words {
+			if strings.ContainsAny(words[k], " \t\n;|&") && len(kubectlInvocations(words[k:k+1])) > 0 {
+				// e.g. 'sh -c "kubectl get pods"'
+				ok = false
+			}
+		}
 	}
+	b.WriteString(s[last:])
+	return b.String(), ok || (flags == "" && e.completer.sessionNamespace() == "")
+}
 
-	out := &bytes.Buffer{}
-	cmd := exec.Command("/bin/sh", "-c", "kubectl "+s)
-	cmd.Stdin = os.Stdin
-	cmd.Stdout = out
-	if err := cmd.Run(); err != nil {
-		debug.Log(err.Error())
-		return ""
+// flagsInsertion returns where flags are put in the arguments of kubectl
+// and the text to insert there. They go after the arguments and before '--'
+// because kubectl refuses flags placed before the name of plugins.
+// end is used if there are no arguments like 'xargs kubectl'.
+func flagsInsertion(args []token, end int, flags string) (int, string) {
+	for _, t := range args {
+		if t.value == "--" {
+			return t.start, flags
+		}
+	}
+	if len(args) > 0 {
+		end = args[len(args)-1].end
+	}
+	return end, " " + strings.TrimSuffix(flags, " ")
+}
+
+// runPipeline runs the command line checked by readOnlyViolation without the
+// shell, so that nothing but kubectl and the filters like '| grep' runs.
+func (e *Executor) runPipeline(s string) error {
+	commands := splitCommands(lex(s))
+	cmds := make([]*exec.Cmd, len(commands))
+	for i, command := range commands {
+		words := tokenValues(command)
+		if i == 0 {
+			cmds[i] = exec.Command("kubectl", e.kubectlCommandArgs(words)...)
+			cmds[i].Stdin = os.Stdin
+		} else {
+			cmds[i] = exec.Command(words[0], words[1:]...)
+			// disable the commands of less like '!' which run the shell.
+			cmds[i].Env = append(os.Environ(), "LESSSECURE=1")
+		}
+		cmds[i].Stderr = os.Stderr
 	}
-	r := string(out.Bytes())
-	return r
+	for i := 1; i < len(cmds); i++ {
+		r, err := cmds[i-1].StdoutPipe()
+		if err != nil {
+			return err
+		}
+		cmds[i].Stdin = r
+	}
+	cmds[len(cmds)-1].Stdout = os.Stdout
+
+	for i := range cmds {
+		if err := cmds[i].Start(); err != nil {
+			for _, started := range cmds[:i] {
+				started.Process.Kill()
+				started.Wait()
+			}
+			return err
+		}
+	}
+	// Like the shell, the pipeline fails if the last command fails.
+	var err error
+	for i := range cmds {
+		err = cmds[i].Wait()
+	}
+	return err
+}
+
+// kubectlCommandArgs returns the arguments of kubectl which runs the command
+// with the flags of kube-prompt and the session namespace. Like shellCommand,
+// the flags are put after the arguments and before '--'.
+func (e *Executor) kubectlCommandArgs(words []string) []string {
+	flags := append(e.completer.kubectlArgs(), e.namespaceArgs(words)...)
+	i := 0
+	for i < len(words) && words[i] != "--" {
+		i++
+	}
+	args := make([]string, 0, len(words)+len(flags))
+	args = append(args, words[:i]...)
+	args = append(args, flags...)
+	return append(args, words[i:]...)
+}
+
+// kubectlCommandIndex returns the index of kubectl run by the shell command
+// like 'kubectl get pods' or 'xargs kubectl delete', or -1 if it isn't kubectl.
+func kubectlCommandIndex(words []string) int {
+	for i, w := range words {
+		if strings.HasPrefix(w, "-") || strings.Contains(w, "=") {
+			// flags of the wrappers or variables like 'FOO=bar kubectl'.
+			continue
+		}
+		if path.Base(w) == "kubectl" {
+			return i
+		}
+		if !containsString(commandWrappers, path.Base(w)) {
+			return -1
+		}
+	}
+	return -1
+}
+
+// kubectlArgs returns the flags given to kube-prompt, which are passed to
+// kubectl so that it targets the same cluster as the completion.
+func (c *Completer) kubectlArgs() []string {
+	var args []string
+	if c.loadingRules.ExplicitPath != "" {
+		args = append(args, "--kubeconfig", c.loadingRules.ExplicitPath)
+	}
+	if c.overrides.CurrentContext != "" {
+		args = append(args, "--context", c.overrides.CurrentContext)
+	}
+	if c.overrides.Context.Namespace != "" {
+		args = append(args, "--namespace", c.overrides.Context.Namespace)
+	}
+	return args
+}
+
+// kubectlFlags is the same as kubectlArgs, but quoted for the shell.
+func (c *Completer) kubectlFlags() string {
+	return shellFlags(c.kubectlArgs())
+}
+
+// shellFlags quotes the values of flags like '--context prod' for the shell.
+func shellFlags(args []string) string {
+	var flags string
+	for i := 0; i < len(args); i += 2 {
+		flags += args[i] + " " + shellQuote(args[i+1]) + " "
+	}
+	return flags
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
 }
@@ -0,0 +1,82 @@
+package kube
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestKubectlFlags(t *testing.T) {
+	c := &Completer{
+		loadingRules: &clientcmd.ClientConfigLoadingRules{},
+		overrides:    &clientcmd.ConfigOverrides{},
+	}
+	if actual := c.kubectlFlags(); actual != "" {
+		t.Errorf("Should be empty without flags, but got %s", actual)
+	}
+
+	c.loadingRules.ExplicitPath = "/home/me/my kube/config"
+	c.overrides.CurrentContext = "prod"
+	c.overrides.Context.Namespace = "it's"
+	expected := `--kubeconfig '/home/me/my kube/config' --context 'prod' --namespace 'it'\''s' `
+	if actual := c.kubectlFlags(); actual != expected {
+		t.Errorf("Should be %s, but got %s", expected, actual)
+	}
+}
+
+func TestShellCommand(t *testing.T) {
+	c := &Completer{
+		loadingRules: &clientcmd.ClientConfigLoadingRules{},
+		overrides:    &clientcmd.ConfigOverrides{CurrentContext: "prod"},
+	}
+	e := NewExecutor(c, &Config{})
+
+	var scenarioTable = []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{input: "get pods", expected: "kubectl get pods --context 'prod'", ok: true},
+		{input: "ns-tree default", expected: "kubectl ns-tree default --context 'prod'", ok: true},
+		{input: "exec api -- ls", expected: "kubectl exec api --context 'prod' -- ls", ok: true},
+		{input: "get pods && kubectl get svc", expected: "kubectl get pods --context 'prod' && kubectl get svc --context 'prod'", ok: true},
+		{input: "get pods -o name | xargs kubectl describe", expected: "kubectl get pods -o name --context 'prod' | xargs kubectl describe --context 'prod'", ok: true},
+		{input: "get pods | grep kubectl", expected: "kubectl get pods --context 'prod' | grep kubectl", ok: true},
+		{input: "get pods -o name | xargs -t env kubectl get", expected: "kubectl get pods -o name --context 'prod' | xargs -t env kubectl get --context 'prod'", ok: true},
+		{input: "get pods; sh -c 'kubectl get svc'", expected: "kubectl get pods --context 'prod'; sh -c 'kubectl get svc'", ok: false},
+	}
+	for _, s := range scenarioTable {
+		actual, ok := e.shellCommand(s.input)
+		if actual != s.expected || ok != s.ok {
+			t.Errorf("%q: should be %q (%v), but got %q (%v)", s.input, s.expected, s.ok, actual, ok)
+		}
+	}
+}
+
+func TestKubectlCommandArgs(t *testing.T) {
+	c := &Completer{
+		context:      "prod",
+		loadingRules: &clientcmd.ClientConfigLoadingRules{},
+		overrides:    &clientcmd.ConfigOverrides{CurrentContext: "prod"},
+		clients: map[string]*contextClient{
+			"prod": {name: "prod", sessionNamespace: "web"},
+		},
+	}
+	e := NewExecutor(c, &Config{})
+
+	var scenarioTable = []struct {
+		input    string
+		expected []string
+	}{
+		{input: "get pods", expected: []string{"get", "pods", "--context", "prod", "--namespace", "web"}},
+		{input: "logs api -n db", expected: []string{"logs", "api", "-n", "db", "--context", "prod"}},
+		{input: "exec api -- ls", expected: []string{"exec", "api", "--context", "prod", "--namespace", "web", "--", "ls"}},
+	}
+	for _, s := range scenarioTable {
+		if actual := e.kubectlCommandArgs(strings.Fields(s.input)); !reflect.DeepEqual(actual, s.expected) {
+			t.Errorf("%q: should be %q, but got %q", s.input, s.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package kube
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+/* Field selectors */
+
+// fieldSelector is a field which the API server accepts in '--field-selector'.
+type fieldSelector struct {
+	field       string
+	description string
+	// values returns the candidates for the value if it is not nil.
+	values func(c *Completer, resourceType, namespace string) []prompt.Suggest
+}
+
+// fieldSelectors are the fields supported for each resource in addition
+// to 'metadata.name' and 'metadata.namespace', which every resource supports.
+var fieldSelectors = map[schema.GroupResource][]fieldSelector{
+	{Resource: "pods"}: {
+		{field: "spec.nodeName", description: "Node the pod is scheduled to", values: nodeNameValues},
+		{field: "spec.restartPolicy", description: "Restart policy of the containers", values: fieldValues("Always", "OnFailure", "Never")},
+		{field: "spec.schedulerName", description: "Scheduler which dispatches the pod", values: podFieldValues(func(p *corev1.Pod) string { return p.Spec.SchedulerName })},
+		{field: "spec.serviceAccountName", description: "ServiceAccount the pod runs as", values: objectNameValues(serviceAccountResource)},
+		{field: "spec.hostNetwork", description: "Whether the pod uses the host network", values: fieldValues("true", "false")},
+		{field: "status.phase", description: "Phase of the pod", values: fieldValues("Pending", "Running", "Succeeded", "Failed", "Unknown")},
+		{field: "status.podIP", description: "IP address of the pod", values: podFieldValues(func(p *corev1.Pod) string { return p.Status.PodIP })},
+		{field: "status.podIPs", description: "IP addresses of the pod", values: podFieldValues(func(p *corev1.Pod) string { return p.Status.PodIP })},
+		{field: "status.nominatedNodeName", description: "Node nominated by preemption", values: nodeNameValues},
+	},
+	{Resource: "events"}: {
+		{field: "involvedObject.kind", description: "Kind of the object the event is about", values: eventFieldValues(func(e *corev1.Event) string { return e.InvolvedObject.Kind })},
+		{field: "involvedObject.name", description: "Name of the object the event is about", values: eventFieldValues(func(e *corev1.Event) string { return e.InvolvedObject.Name })},
+		{field: "involvedObject.namespace", description: "Namespace of the object the event is about", values: eventFieldValues(func(e *corev1.Event) string { return e.InvolvedObject.Namespace })},
+		{field: "involvedObject.uid", description: "UID of the object the event is about"},
+		{field: "involvedObject.apiVersion", description: "API version of the object the event is about", values: eventFieldValues(func(e *corev1.Event) string { return e.InvolvedObject.APIVersion })},
+		{field: "involvedObject.resourceVersion", description: "Resource version of the object the event is about"},
+		{field: "involvedObject.fieldPath", description: "Field of the object like 'spec.containers{web}'", values: eventFieldValues(func(e *corev1.Event) string { return e.InvolvedObject.FieldPath })},
+		{field: "reason", description: "Reason of the event like 'BackOff'", values: eventFieldValues(func(e *corev1.Event) string { return e.Reason })},
+		{field: "reportingComponent", description: "Controller which emitted the event", values: eventFieldValues(func(e *corev1.Event) string { return e.ReportingController })},
+		{field: "source", description: "Component which reported the event", values: eventFieldValues(func(e *corev1.Event) string { return e.Source.Component })},
+		{field: "type", description: "Type of the event", values: fieldValues("Normal", "Warning")},
+	},
+	{Resource: "namespaces"}: {
+		{field: "status.phase", description: "Phase of the namespace", values: fieldValues("Active", "Terminating")},
+	},
+	{Resource: "nodes"}: {
+		{field: "spec.unschedulable", description: "Whether the node is cordoned", values: fieldValues("true", "false")},
+	},
+	{Resource: "replicationcontrollers"}: {
+		{field: "status.replicas", description: "Number of the replicas"},
+	},
+	{Resource: "secrets"}: {
+		{field: "type", description: "Type of the secret", values: fieldValues(
+			string(corev1.SecretTypeOpaque),
+			string(corev1.SecretTypeServiceAccountToken),
+			string(corev1.SecretTypeDockercfg),
+			string(corev1.SecretTypeDockerConfigJson),
+			string(corev1.SecretTypeBasicAuth),
+			string(corev1.SecretTypeSSHAuth),
+			string(corev1.SecretTypeTLS),
+			string(corev1.SecretTypeBootstrapToken),
+		)},
+	},
+	{Group: "apps", Resource: "replicasets"}: {
+		{field: "status.replicas", description: "Number of the replicas"},
+	},
+	{Group: "batch", Resource: "jobs"}: {
+		{field: "status.successful", description: "Number of the succeeded pods"},
+	},
+	{Group: "certificates.k8s.io", Resource: "certificatesigningrequests"}: {
+		{field: "spec.signerName", description: "Signer requested to sign the certificate"},
+	},
+}
+
+func fieldValues(values ...string) func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+	suggests := make([]prompt.Suggest, len(values))
+	for i := range values {
+		suggests[i] = prompt.Suggest{Text: values[i]}
+	}
+	return func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+		return suggests
+	}
+}
+
+func objectNameValues(r *resourceDescriptor) func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+	return func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+		return c.getObjectSuggestions(r, namespace)
+	}
+}
+
+var nodeNameValues = objectNameValues(nodeResource)
+
+func podFieldValues(field func(p *corev1.Pod) string) func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+	return func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+		return uniqueFieldValues(c.listFullObjects(podResource, namespace), func(obj interface{}) (string, string) {
+			p, ok := obj.(*corev1.Pod)
+			if !ok {
+				return "", ""
+			}
+			return field(p), p.Name
+		})
+	}
+}
+
+func eventFieldValues(field func(e *corev1.Event) string) func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+	return func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+		return uniqueFieldValues(c.listFullObjects(eventResource, namespace), func(obj interface{}) (string, string) {
+			e, ok := obj.(*corev1.Event)
+			if !ok {
+				return "", ""
+			}
+			return field(e), e.InvolvedObject.Kind + "/" + e.InvolvedObject.Name + ": " + e.Reason
+		})
+	}
+}
+
+// uniqueFieldValues returns the distinct values of the objects. The description
+// is taken from the first object, or the number of objects if there are many.
+func uniqueFieldValues(l []interface{}, field func(obj interface{}) (value, description string)) []prompt.Suggest {
+	counts := make(map[string]int)
+	descriptions := make(map[string]string)
+	for i := range l {
+		v, description := field(l[i])
+		if v == "" {
+			continue
+		}
+		if counts[v] == 0 {
+			descriptions[v] = description
+		}
+		counts[v]++
+	}
+	suggests := make([]prompt.Suggest, 0, len(counts))
+	for v, n := range counts {
+		description := descriptions[v]
+		if n > 1 {
+			description = strconv.Itoa(n) + " objects"
+		}
+		suggests = append(suggests, prompt.Suggest{Text: v, Description: description})
+	}
+	sort.Slice(suggests, func(i, j int) bool {
+		return suggests[i].Text < suggests[j].Text
+	})
+	return suggests
+}
+
+// lookupFieldSelectors returns the fields supported by the resource type typed by users.
+func (c *Completer) lookupFieldSelectors(resourceType string) []fieldSelector {
+	var gr schema.GroupResource
+	var namespaced bool
+	if r, ok := lookupResourceDescriptor(resourceType); ok {
+		gr, namespaced = r.gvr.GroupResource(), r.namespaced
+	} else if r, ok := lookupAPIResource(c.discoveryClient(), c.context, resourceType); ok {
+		gr, namespaced = r.gvr.GroupResource(), r.namespaced
+	} else {
+		return nil
+	}
+
+	fields := []fieldSelector{{
+		field:       "metadata.name",
+		description: "Name of the object",
+		values: func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+			return c.getResourceNameSuggestions(context.TODO(), namespace, resourceType)
+		},
+	}}
+	if namespaced {
+		fields = append(fields, fieldSelector{
+			field:       "metadata.namespace",
+			description: "Namespace of the object",
+			values: func(c *Completer, resourceType, namespace string) []prompt.Suggest {
+				return getNameSpaceSuggestions(c.namespaceList)
+			},
+		})
+	}
+	return append(fields, fieldSelectors[gr]...)
+}
+
+// fieldSelectorSuggestions completes the last requirement of the field selector
+// like 'status.phase=Run' in 'spec.nodeName=node1,status.phase=Run'.
+// Suggestions are the whole selectors.
+func (c *Completer) fieldSelectorSuggestions(resourceType, namespace, value string) []prompt.Suggest {
+	fields := c.lookupFieldSelectors(resourceType)
+	start := strings.LastIndexByte(value, ',') + 1
+	clause := value[start:]
+
+	if i, j, found := cutSelectorOperator(clause); found {
+		if j < 0 {
+			return []prompt.Suggest{}
+		}
+		for _, f := range fields {
+			if f.field != clause[:i] || f.values == nil {
+				continue
+			}
+			suggests := f.values(c, resourceType, namespace)
+			result := make([]prompt.Suggest, len(suggests))
+			for k := range suggests {
+				result[k] = prompt.Suggest{Text: value[:start+j] + suggests[k].Text, Description: suggests[k].Description}
+			}
+			return result
+		}
+		return []prompt.Suggest{}
+	}
+
+	suggests := make([]prompt.Suggest, len(fields))
+	for i, f := range fields {
+		suggests[i] = prompt.Suggest{Text: value[:start] + f.field, Description: f.description}
+	}
+	return suggests
+}
@@ -0,0 +1,38 @@
+package kube
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFieldSelectorSuggestions(t *testing.T) {
+	c := &Completer{}
+
+	var scenarioTable = []struct {
+		resourceType string
+		value        string
+		expected     []string
+	}{
+		{resourceType: "po", value: "status.", expected: []string{"status.phase", "status.podIP", "status.podIPs", "status.nominatedNodeName"}},
+		{resourceType: "pods", value: "status.phase=", expected: []string{"status.phase=Pending", "status.phase=Running", "status.phase=Succeeded", "status.phase=Failed", "status.phase=Unknown"}},
+		{resourceType: "pods", value: "spec.nodeName=node1,status.phase!=Run", expected: []string{"spec.nodeName=node1,status.phase!=Running"}},
+		{resourceType: "nodes", value: "metadata.", expected: []string{"metadata.name"}},
+		{resourceType: "secrets", value: "type=kubernetes.io/t", expected: []string{"type=kubernetes.io/tls"}},
+		{resourceType: "configmaps", value: "data.", expected: []string{}},
+		{resourceType: "pods", value: "status.phase!", expected: []string{}},
+	}
+
+	for _, s := range scenarioTable {
+		actual := c.fieldSelectorSuggestions(s.resourceType, "default", s.value)
+		texts := make([]string, 0, len(actual))
+		for i := range actual {
+			if strings.HasPrefix(actual[i].Text, s.value) {
+				texts = append(texts, actual[i].Text)
+			}
+		}
+		if !reflect.DeepEqual(texts, s.expected) {
+			t.Errorf("%s %q: should be %v, but got %v", s.resourceType, s.value, s.expected, texts)
+		}
+	}
+}
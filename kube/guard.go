@@ -0,0 +1,266 @@
+package kube
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/c-bata/kube-prompt/internal/debug"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var defaultDangerousCommands = []string{
+	"delete",
+	"drain",
+	"scale --replicas=0",
+	"replace --force",
+	"rollout undo",
+}
+
+// commandPattern is a pattern of kubectl commands like 'scale --replicas=0'.
+type commandPattern struct {
+	words []string
+	flags []string
+}
+
+func parseCommandPattern(s string) commandPattern {
+	var p commandPattern
+	for _, f := range strings.Fields(s) {
+		if strings.HasPrefix(f, "-") {
+			p.flags = append(p.flags, f)
+		} else {
+			p.words = append(p.words, f)
+		}
+	}
+	return p
+}
+
+func (p commandPattern) match(args []string) bool {
+	commandArgs, _ := excludeOptions(args)
+	if len(p.words) == 0 || len(commandArgs) < len(p.words) {
+		return false
+	}
+	for i := range p.words {
+		if commandArgs[i] != p.words[i] {
+			return false
+		}
+	}
+	for _, f := range p.flags {
+		if !hasFlag(args, f) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasFlag checks that args have the flag like '--force' or '--replicas=0'.
+// '--replicas=0' also matches '--replicas 0'.
+func hasFlag(args []string, flag string) bool {
+	name, value, hasValue := strings.Cut(flag, "=")
+	args = kubectlFlagArgs(args)
+	for i := range args {
+		if !hasValue {
+			if args[i] == name || args[i] == name+"=true" {
+				return true
+			}
+			continue
+		}
+		if args[i] == flag {
+			return true
+		}
+		if args[i] == name && i+1 < len(args) && args[i+1] == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (c ProtectionConfig) isProtected(contextName string) bool {
+	for _, pattern := range c.Contexts {
+		if ok, _ := path.Match(pattern, contextName); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// dangerousCommand returns the pattern matched with the command.
+func (c ProtectionConfig) dangerousCommand(args []string) (commandPattern, bool) {
+	commands := c.Commands
+	if len(commands) == 0 {
+		commands = defaultDangerousCommands
+	}
+	for _, command := range commands {
+		if p := parseCommandPattern(command); p.match(args) {
+			return p, true
+		}
+	}
+	return commandPattern{}, false
+}
+
+// confirm asks users to type the context name before running dangerous
+// commands on protected contexts. Every kubectl command in the line is checked
+// with the context it targets like '--context prod'. It returns true if the
+// command can be run.
+func (e *Executor) confirm(s string) bool {
+	var reader *bufio.Reader
+	for _, args := range kubectlCommands(lex(s)) {
+		contextName, err := e.completer.lineContextName(args)
+		if err != nil {
+			debug.Log(err.Error())
+			contextName = e.completer.context
+		}
+		if !e.config.Protection.isProtected(contextName) {
+			continue
+		}
+		p, ok := e.config.Protection.dangerousCommand(args)
+		if !ok {
+			continue
+		}
+
+		fmt.Printf("%q is a protected context.\n", contextName)
+		fmt.Printf("This command affects:\n")
+		for _, o := range e.completer.affectedObjectsOn(p, args) {
+			fmt.Printf("  %s\n", o)
+		}
+		fmt.Printf("Type the context name to continue: ")
+		if reader == nil {
+			reader = bufio.NewReader(e.stdin)
+		}
+		answer, _ := reader.ReadString('\n')
+		if strings.TrimSpace(answer) != contextName {
+			return false
+		}
+	}
+	return true
+}
+
+// affectedObjectsOn resolves the affected objects with the clients of the
+// context which the command targets.
+func (c *Completer) affectedObjectsOn(p commandPattern, args []string) []string {
+	lc, err := c.forLine(context.TODO(), args)
+	if err != nil {
+		debug.Log(err.Error())
+		return []string{"(could not resolve objects without the connection to the cluster)"}
+	}
+	return lc.affectedObjects(p, args)
+}
+
+// affectedObjects resolves objects which will be affected by the command from the cache.
+func (c *Completer) affectedObjects(p commandPattern, args []string) []string {
+	if v, ok := flagValue(args, "-f", "--filename"); ok {
+		return []string{"objects in " + v}
+	}
+	if c.client == nil {
+		return []string{"(could not resolve objects without the connection to the cluster)"}
+	}
+
+	commandArgs, _ := excludeOptions(args)
+	positional := commandArgs[len(p.words):]
+	resourceType := ""
+	if p.words[0] == "drain" {
+		resourceType = "nodes"
+	} else if len(positional) > 0 && !strings.Contains(positional[0], "/") {
+		resourceType = positional[0]
+		positional = positional[1:]
+	}
+
+	namespace := c.namespace
+	if v, ok := flagValue(args, "-n", "--namespace"); ok {
+		namespace = v
+	}
+	if hasFlag(args, "-A") || hasFlag(args, "--all-namespaces") {
+		namespace = ""
+	}
+
+	var result []string
+	for _, name := range positional {
+		t := resourceType
+		if x := strings.SplitN(name, "/", 2); len(x) == 2 {
+			t, name = x[0], x[1]
+		}
+		if t == "" {
+			continue
+		}
+		result = append(result, c.describeObject(t, namespace, name))
+	}
+
+	selector, hasSelector := flagValue(args, "-l", "--selector")
+	if resourceType != "" && (hasSelector || hasFlag(args, "--all")) {
+		result = append(result, c.selectObjects(resourceType, namespace, selector)...)
+	}
+	if len(result) == 0 {
+		return []string{"(could not resolve objects from the cache)"}
+	}
+	return result
+}
+
+func (c *Completer) describeObject(resourceType, namespace, name string) string {
+	s := resourceType + "/" + name
+	l, ok := c.listSyncedObjectsOf(resourceType, namespace)
+	if !ok {
+		return s
+	}
+	for _, o := range l {
+		if o.GetName() != name {
+			continue
+		}
+		if o.GetNamespace() != "" {
+			return s + " (namespace: " + o.GetNamespace() + ")"
+		}
+		return s
+	}
+	return s + " (not found)"
+}
+
+func (c *Completer) selectObjects(resourceType, namespace, selector string) []string {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return []string{"invalid selector: " + err.Error()}
+	}
+	l, ok := c.listSyncedObjectsOf(resourceType, namespace)
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, len(l))
+	for _, o := range l {
+		if !sel.Matches(labels.Set(o.GetLabels())) {
+			continue
+		}
+		s := resourceType + "/" + o.GetName()
+		if o.GetNamespace() != "" {
+			s += " (namespace: " + o.GetNamespace() + ")"
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+// flagValue returns the value of the flag like '-n foo', '-n=foo' or '--namespace=foo'.
+func flagValue(args []string, names ...string) (string, bool) {
+	args = kubectlFlagArgs(args)
+	for i := range args {
+		for _, name := range names {
+			if args[i] == name && i+1 < len(args) {
+				return args[i+1], true
+			}
+			if strings.HasPrefix(args[i], name+"=") {
+				return strings.TrimPrefix(args[i], name+"="), true
+			}
+		}
+	}
+	return "", false
+}
+
+// kubectlFlagArgs drops the arguments after '--' like 'ls -A' in
+// 'exec web -- ls -A', which are not the flags of kubectl.
+func kubectlFlagArgs(args []string) []string {
+	for i := range args {
+		if args[i] == "--" {
+			return args[:i]
+		}
+	}
+	return args
+}
@@ -0,0 +1,130 @@
+package kube
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestDangerousCommand(t *testing.T) {
+	config := ProtectionConfig{Contexts: []string{"*prod*"}}
+	if !config.isProtected("prod-eu") || config.isProtected("staging") {
+		t.Errorf("Only prod-eu should be protected")
+	}
+
+	var scenarioTable = []struct {
+		input    string
+		expected bool
+	}{
+		{input: "delete pods web-1", expected: true},
+		{input: "drain node-1 --ignore-daemonsets", expected: true},
+		{input: "scale deploy/web --replicas=0", expected: true},
+		{input: "scale deploy/web --replicas 0", expected: true},
+		{input: "scale deploy/web --replicas=3", expected: false},
+		{input: "replace -f web.yaml --force", expected: true},
+		{input: "replace -f web.yaml", expected: false},
+		{input: "rollout undo deploy/web", expected: true},
+		{input: "rollout status deploy/web", expected: false},
+		{input: "get pods", expected: false},
+	}
+	for _, s := range scenarioTable {
+		if _, actual := config.dangerousCommand(strings.Fields(s.input)); actual != s.expected {
+			t.Errorf("%s: should be %v, but got %v", s.input, s.expected, actual)
+		}
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	c := &Completer{
+		context:   "prod-eu",
+		namespace: "payments",
+		client:    fake.NewSimpleClientset(),
+		metadataClient: newTestMetadataClient(t,
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "payments", Labels: map[string]string{"app": "web"}},
+			},
+			&metav1.PartialObjectMetadata{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "payments", Labels: map[string]string{"app": "api"}},
+			},
+		),
+	}
+	e := NewExecutor(c, &Config{Protection: ProtectionConfig{Contexts: []string{"*prod*"}}})
+
+	e.stdin = strings.NewReader("prod-eu\n")
+	if !e.confirm("delete cm web") {
+		t.Errorf("Should be confirmed by typing the context name")
+	}
+	e.stdin = strings.NewReader("y\n")
+	if e.confirm("delete cm web") {
+		t.Errorf("Should not be confirmed")
+	}
+	e.stdin = strings.NewReader("")
+	if !e.confirm("get cm web") {
+		t.Errorf("Should not ask for confirmation of safe commands")
+	}
+	e.stdin = strings.NewReader("")
+	if e.confirm("get cm web && kubectl delete cm web") {
+		t.Errorf("Should ask for confirmation of dangerous commands after shell operators")
+	}
+	e.stdin = strings.NewReader("prod-eu\n")
+	if !e.confirm("get cm web | xargs kubectl delete cm") {
+		t.Errorf("Should be confirmed by typing the context name")
+	}
+
+	p := parseCommandPattern("delete")
+	actual := c.affectedObjects(p, strings.Fields("delete cm -l app=web"))
+	expected := []string{"cm/web (namespace: payments)"}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %#v, but got %#v", expected, actual)
+	}
+	actual = c.affectedObjects(p, strings.Fields("delete cm/api cm/unknown"))
+	expected = []string{"cm/api (namespace: payments)", "cm/unknown (not found)"}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %#v, but got %#v", expected, actual)
+	}
+
+	// The context typed on the line is protected.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"NamespaceList","apiVersion":"v1","items":[]}`))
+	}))
+	defer server.Close()
+
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	writeTestKubeconfig(t, kubeconfig, server.URL, "staging")
+	c = &Completer{
+		loadingRules: &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+		overrides:    &clientcmd.ConfigOverrides{},
+		clients:      make(map[string]*contextClient),
+	}
+	if err := c.reloadContext(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	e = NewExecutor(c, &Config{Protection: ProtectionConfig{Contexts: []string{"production"}}})
+
+	e.stdin = strings.NewReader("")
+	if !e.confirm("delete -f web.yaml") {
+		t.Errorf("Should not ask for confirmation on staging")
+	}
+	if e.confirm("--context production delete -f web.yaml") {
+		t.Errorf("Should ask for confirmation on the context typed on the line")
+	}
+	e.stdin = strings.NewReader("staging\n")
+	if e.confirm("delete -f web.yaml --context=production") {
+		t.Errorf("Should not be confirmed by the name of the session context")
+	}
+	e.stdin = strings.NewReader("production\n")
+	if !e.confirm("delete -f web.yaml --context=production") {
+		t.Errorf("Should be confirmed by typing the context name")
+	}
+}
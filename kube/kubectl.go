@@ -0,0 +1,201 @@
+package kube
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c-bata/kube-prompt/internal/debug"
+	"github.com/c-bata/kube-prompt/internal/optionconv"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const kubectlVersionTimeout = 3 * time.Second
+
+// installedKubectl keeps the flags of the kubectl on PATH when its version
+// differs from bundledKubectlVersion. They are parsed from the help text of
+// each command lazily and cached on disk per version.
+var installedKubectl = &kubectlHelp{}
+
+type kubectlHelp struct {
+	// version is empty if the bundled tables match the installed kubectl.
+	version  string
+	cacheDir string
+	options  sync.Map
+}
+
+// detectKubectlVersion checks 'kubectl version --client' and switches to the
+// flags of the installed kubectl if its minor version differs from the bundled one.
+func detectKubectlVersion() {
+	v, err := kubectlClientVersion()
+	if err != nil {
+		debug.Log("failed to detect kubectl version: " + err.Error())
+		return
+	}
+	if sameMinorVersion(v, bundledKubectlVersion) {
+		return
+	}
+	debug.Log("kubectl " + v + " differs from the bundled " + bundledKubectlVersion)
+	installedKubectl.version = v
+	if dir, err := os.UserCacheDir(); err == nil {
+		installedKubectl.cacheDir = filepath.Join(dir, "kube-prompt", "kubectl-"+v)
+	}
+}
+
+func kubectlClientVersion() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), kubectlVersionTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "kubectl", "version", "--client", "-o", "json").Output()
+	if err != nil {
+		return "", err
+	}
+	var v struct {
+		ClientVersion struct {
+			GitVersion string `json:"gitVersion"`
+		} `json:"clientVersion"`
+	}
+	if err := json.Unmarshal(out, &v); err != nil {
+		return "", err
+	}
+	return v.ClientVersion.GitVersion, nil
+}
+
+func sameMinorVersion(a, b string) bool {
+	va, err := version.ParseGeneric(a)
+	if err != nil {
+		return true
+	}
+	vb, err := version.ParseGeneric(b)
+	if err != nil {
+		return true
+	}
+	return va.Major() == vb.Major() && va.Minor() == vb.Minor()
+}
+
+// commandOptions returns the flags of the last command in the path.
+func commandOptions(path []*command) []option {
+	c := path[len(path)-1]
+	if installedKubectl.version == "" {
+		return c.options
+	}
+	name := commandName(path)
+	if o, ok := installedKubectl.get(name, c.options); ok {
+		return o
+	}
+	return c.options
+}
+
+// get returns the flags of the command like 'rollout history'. The empty
+// name means the global flags. It starts to read the help text in background
+// and returns false until it is finished. The bundled flags complement the
+// metadata which the help text doesn't show.
+func (h *kubectlHelp) get(name string, bundled []option) ([]option, bool) {
+	if x, ok := h.options.Load(name); ok {
+		return x.([]option), true
+	}
+	if flags, ok := h.readCache(name); ok {
+		o := flagsToOptions(flags, bundled)
+		h.options.Store(name, o)
+		return o, true
+	}
+	go h.fetch(name, bundled)
+	return nil, false
+}
+
+func (h *kubectlHelp) fetch(name string, bundled []option) {
+	key := "kubectl_help_" + name
+	if !shouldFetch(key) {
+		return
+	}
+	updateLastFetchedAt(key)
+
+	args := append(strings.Fields(name), "--help")
+	if name == "" {
+		args = []string{"options"}
+	}
+	out := &bytes.Buffer{}
+	cmd := exec.Command("kubectl", args...)
+	cmd.Stdout = out
+	if err := cmd.Run(); err != nil {
+		debug.Log(err.Error())
+		return
+	}
+	flags, err := optionconv.GetFlagsFromHelpText(out.String())
+	if err != nil {
+		debug.Log("failed to parse 'kubectl " + strings.Join(args, " ") + "': " + err.Error())
+		return
+	}
+	h.options.Store(name, flagsToOptions(flags, bundled))
+	h.writeCache(name, flags)
+}
+
+func (h *kubectlHelp) cachePath(name string) string {
+	if name == "" {
+		name = "options"
+	}
+	return filepath.Join(h.cacheDir, strings.ReplaceAll(name, " ", "_")+".json")
+}
+
+func (h *kubectlHelp) readCache(name string) ([]optionconv.Flag, bool) {
+	if h.cacheDir == "" {
+		return nil, false
+	}
+	b, err := os.ReadFile(h.cachePath(name))
+	if err != nil {
+		return nil, false
+	}
+	var flags []optionconv.Flag
+	if err := json.Unmarshal(b, &flags); err != nil {
+		debug.Log(err.Error())
+		return nil, false
+	}
+	return flags, true
+}
+
+func (h *kubectlHelp) writeCache(name string, flags []optionconv.Flag) {
+	if h.cacheDir == "" {
+		return
+	}
+	b, err := json.Marshal(flags)
+	if err != nil {
+		debug.Log(err.Error())
+		return
+	}
+	if err := os.MkdirAll(h.cacheDir, 0755); err != nil {
+		debug.Log(err.Error())
+		return
+	}
+	if err := os.WriteFile(h.cachePath(name), b, 0644); err != nil {
+		debug.Log(err.Error())
+	}
+}
+
+// flagsToOptions converts the flags parsed from the help text. The help text
+// doesn't show the value used without '=' like 'none' of '--dry-run', so it is
+// taken from the bundled flag of the same name. Otherwise such flags would
+// consume the next argument.
+func flagsToOptions(flags []optionconv.Flag, bundled []option) []option {
+	options := make([]option, len(flags))
+	for i := range flags {
+		options[i] = option{
+			name:         flags[i].Name,
+			shorthand:    flags[i].Shorthand,
+			valueType:    flags[i].Type,
+			defaultValue: flags[i].Default,
+			description:  flags[i].Description,
+		}
+		for j := range bundled {
+			if bundled[j].name == flags[i].Name {
+				options[i].noOptDefault = bundled[j].noOptDefault
+				break
+			}
+		}
+	}
+	return options
+}
@@ -0,0 +1,82 @@
+package kube
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const fakeKubectl = `#!/bin/sh
+case "$1" in
+version)
+  echo '{"clientVersion": {"gitVersion": "v1.25.16"}}';;
+get)
+  printf 'Options:\n    --only-in-old-kubectl=false:\n\tSomething removed later.\n\nUsage:\n  kubectl get [options]\n';;
+delete)
+  printf "Options:\n    --dry-run='none':\n\tMust be \"none\", \"server\", or \"client\".\n\nUsage:\n  kubectl delete [options]\n";;
+esac
+`
+
+func TestInstalledKubectl(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "kubectl"), []byte(fakeKubectl), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	defer func() { installedKubectl = &kubectlHelp{} }()
+
+	detectKubectlVersion()
+	if installedKubectl.version != "v1.25.16" {
+		t.Fatalf("Should be v1.25.16, but got %q", installedKubectl.version)
+	}
+
+	path, _ := lookupCommand([]string{"get"})
+	var found bool
+	for i := 0; i < 30 && !found; i++ {
+		_, found = lookupOption(path, "--only-in-old-kubectl")
+		time.Sleep(100 * time.Millisecond)
+	}
+	if !found {
+		t.Fatalf("Should use the flags of the installed kubectl")
+	}
+	if _, err := os.Stat(installedKubectl.cachePath("get")); err != nil {
+		t.Errorf("Should be cached on disk, but got %s", err)
+	}
+
+	// '--dry-run' doesn't take the next argument like the bundled one.
+	found = false
+	path, _ = lookupCommand([]string{"delete"})
+	for i := 0; i < 30 && !found; i++ {
+		_, _ = lookupOption(path, "--dry-run")
+		_, found = installedKubectl.options.Load("delete")
+		time.Sleep(100 * time.Millisecond)
+	}
+	o, _ := lookupOption(path, "--dry-run")
+	if o.valueType != "string" || o.noOptDefault != "unchanged" {
+		t.Errorf("Should be a string flag with the no-option default, but got %#v", o)
+	}
+	expected := []string{"delete", "pod", "web"}
+	if actual, _ := excludeOptions([]string{"delete", "--dry-run", "pod", "web"}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Should be %v, but got %v", expected, actual)
+	}
+}
+
+func TestSameMinorVersion(t *testing.T) {
+	var scenarioTable = []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "v1.30.3", b: "v1.30.0", expected: true},
+		{a: "v1.30.3", b: "v1.29.3", expected: false},
+		{a: "v1.30.3-gke.1", b: "v1.30.3", expected: true},
+	}
+	for _, s := range scenarioTable {
+		if actual := sameMinorVersion(s.a, s.b); actual != s.expected {
+			t.Errorf("%s and %s: should be %v, but got %v", s.a, s.b, s.expected, actual)
+		}
+	}
+}
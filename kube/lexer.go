@@ -0,0 +1,155 @@
+package kube
+
+import (
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	// wordToken is an argument like 'pods', '-n' or "'app in (a,b)'".
+	wordToken tokenKind = iota
+	// operatorToken is a shell control operator like '|', '&&' or ';'.
+	operatorToken
+)
+
+// token is a word or an operator of a command line.
+type token struct {
+	kind tokenKind
+	// value is unquoted and unescaped. For operators, it is the operator itself.
+	value string
+	// start and end are the byte offsets of the token in the command line.
+	start int
+	end   int
+	// expansion is true if the word contains parameter expansion or command
+	// substitution like '$FOO', '$(...)' or '`...`' outside single quotes.
+	expansion bool
+	// redirection is true if the word contains '<' or '>' outside quotes
+	// like '>out.txt' or "bash<<<'...'".
+	redirection bool
+}
+
+// operators are ordered so that longer operators are matched first.
+var operators = []string{"&&", "||", ";;", "|", "&", ";", "(", ")", "\n"}
+
+func isBlank(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r'
+}
+
+// lex splits a command line into words and shell operators like sh does,
+// handling quotes and backslash escapes. An unterminated quote runs to the
+// end of the line, so that the word under the cursor can be completed.
+func lex(s string) []token {
+	var tokens []token
+	i := 0
+	for i < len(s) {
+		if isBlank(s[i]) {
+			i++
+			continue
+		}
+		if op, ok := operatorAt(s, i); ok {
+			tokens = append(tokens, token{kind: operatorToken, value: op, start: i, end: i + len(op)})
+			i += len(op)
+			continue
+		}
+
+		t := token{kind: wordToken, start: i}
+		var value strings.Builder
+	word:
+		for i < len(s) {
+			switch c := s[i]; {
+			case isBlank(c):
+				break word
+			case c == '\\':
+				if i+1 < len(s) {
+					if s[i+1] != '\n' {
+						value.WriteByte(s[i+1])
+					}
+					i += 2
+				} else {
+					i++
+				}
+			case c == '\'':
+				end := strings.IndexByte(s[i+1:], '\'')
+				if end < 0 {
+					value.WriteString(s[i+1:])
+					i = len(s)
+					break word
+				}
+				value.WriteString(s[i+1 : i+1+end])
+				i += end + 2
+			case c == '"':
+				i++
+				for i < len(s) && s[i] != '"' {
+					if s[i] == '\\' && i+1 < len(s) && strings.IndexByte("\"\\$`", s[i+1]) >= 0 {
+						value.WriteByte(s[i+1])
+						i += 2
+						continue
+					}
+					if isExpansionAt(s, i) {
+						t.expansion = true
+					}
+					value.WriteByte(s[i])
+					i++
+				}
+				if i < len(s) {
+					i++ // closing quote
+				}
+			default:
+				if _, ok := operatorAt(s, i); ok {
+					break word
+				}
+				if isExpansionAt(s, i) {
+					t.expansion = true
+				}
+				if c == '<' || c == '>' {
+					t.redirection = true
+				}
+				value.WriteByte(c)
+				i++
+			}
+		}
+		t.value = value.String()
+		t.end = i
+		tokens = append(tokens, t)
+	}
+	return tokens
+}
+
+func operatorAt(s string, i int) (string, bool) {
+	for _, op := range operators {
+		if strings.HasPrefix(s[i:], op) {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func isExpansionAt(s string, i int) bool {
+	if s[i] == '`' {
+		return true
+	}
+	return s[i] == '$' && i+1 < len(s) && !isBlank(s[i+1]) && s[i+1] != '"'
+}
+
+// splitCommands splits tokens into commands separated by shell operators.
+// Each command contains only words.
+func splitCommands(tokens []token) [][]token {
+	commands := [][]token{nil}
+	for _, t := range tokens {
+		if t.kind == operatorToken {
+			commands = append(commands, nil)
+			continue
+		}
+		commands[len(commands)-1] = append(commands[len(commands)-1], t)
+	}
+	return commands
+}
+
+func tokenValues(tokens []token) []string {
+	values := make([]string, len(tokens))
+	for i := range tokens {
+		values[i] = tokens[i].value
+	}
+	return values
+}
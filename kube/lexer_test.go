@@ -0,0 +1,79 @@
+package kube
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/c-bata/go-prompt"
+)
+
+func TestLex(t *testing.T) {
+	var scenarioTable = []struct {
+		input    string
+		expected []string
+	}{
+		{input: "get  pods", expected: []string{"get", "pods"}},
+		{input: "get pods -l 'app in (a,b)'", expected: []string{"get", "pods", "-l", "app in (a,b)"}},
+		{input: `get pods -l "app=web"`, expected: []string{"get", "pods", "-l", "app=web"}},
+		{input: `get pods -o=jsonpath="{.items[*]}"`, expected: []string{"get", "pods", "-o=jsonpath={.items[*]}"}},
+		{input: `get my\ pod`, expected: []string{"get", "my pod"}},
+		{input: "get pods|grep web&&echo ok;", expected: []string{"get", "pods", "|", "grep", "web", "&&", "echo", "ok", ";"}},
+		{input: "get pods -l 'app in (a,", expected: []string{"get", "pods", "-l", "app in (a,"}},
+	}
+
+	for _, s := range scenarioTable {
+		actual := tokenValues(lex(s.input))
+		if !reflect.DeepEqual(actual, s.expected) {
+			t.Errorf("Should be %q, but got %q", s.expected, actual)
+		}
+	}
+}
+
+func TestParseCommandLine(t *testing.T) {
+	var scenarioTable = []struct {
+		input    string
+		expected []string
+		ok       bool
+	}{
+		{input: "get  pods ", expected: []string{"get", "pods", ""}, ok: true},
+		{input: "get pods -n=kube-", expected: []string{"get", "pods", "-n=kube-"}, ok: true},
+		{input: "get nodes && kubectl get po", expected: []string{"get", "po"}, ok: true},
+		{input: "get nodes; ", ok: false},
+		{input: "get pods | grep ", ok: false},
+		{input: "get pods -l 'app in (a, ", expected: []string{"get", "pods", "-l", "app in (a, "}, ok: true},
+	}
+
+	for _, s := range scenarioTable {
+		line, ok := parseCommandLine(s.input)
+		if ok != s.ok {
+			t.Errorf("%q: should be ok=%v, but got %v", s.input, s.ok, ok)
+			continue
+		}
+		if ok && !reflect.DeepEqual(line.args, s.expected) {
+			t.Errorf("%q: should be %q, but got %q", s.input, s.expected, line.args)
+		}
+	}
+}
+
+func TestReplacements(t *testing.T) {
+	var scenarioTable = []struct {
+		input    string
+		suggest  string
+		prefix   string
+		expected string
+	}{
+		{input: "get pods we", suggest: "web", prefix: "", expected: "web"},
+		{input: "get pods -n=kube-", suggest: "kube-system", prefix: "-n=", expected: "-n=kube-system"},
+		{input: "get pods -l 'app in (a,", suggest: "app in (a,b", prefix: "", expected: "(a,b"},
+		{input: "get deploy -o jsonpath='{.spec.rep", suggest: "jsonpath={.spec.replicas", prefix: "", expected: "jsonpath='{.spec.replicas"},
+		{input: "get deploy -o=custom-columns=\"NAME:.metadata.na", suggest: "custom-columns=NAME:.metadata.name", prefix: "-o=", expected: "-o=custom-columns=\"NAME:.metadata.name"},
+	}
+
+	for _, s := range scenarioTable {
+		line, _ := parseCommandLine(s.input)
+		actual := line.replacements([]prompt.Suggest{{Text: s.suggest}}, s.prefix)
+		if len(actual) != 1 || actual[0].Text != s.expected {
+			t.Errorf("%q: should be %s, but got %v", s.input, s.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package kube
+
+import (
+	"fmt"
+	"strings"
+)
+
+// setSessionNamespace changes the namespace of the current context only in
+// this session. "-" switches back to the previous namespace.
+func (c *Completer) setSessionNamespace(namespace string) error {
+	cc, ok := c.clients[c.context]
+	if !ok {
+		return fmt.Errorf("context %q is not loaded", c.context)
+	}
+	if namespace == "-" {
+		if cc.previousNamespace == "" {
+			return fmt.Errorf("no previous namespace")
+		}
+		namespace = cc.previousNamespace
+	}
+	if !c.namespaceExists(namespace) {
+		return fmt.Errorf("namespace %q is not found", namespace)
+	}
+	cc.previousNamespace = c.namespace
+	cc.sessionNamespace = namespace
+	c.namespace = namespace
+	return nil
+}
+
+// sessionNamespace returns the namespace set by 'ns' command.
+func (c *Completer) sessionNamespace() string {
+	cc, ok := c.clients[c.context]
+	if !ok {
+		return ""
+	}
+	return cc.sessionNamespace
+}
+
+// lineSessionNamespace returns the session namespace of the context which the
+// arguments target like '--context prod', so that kubectl runs in the same
+// namespace as the completion of the line.
+func (c *Completer) lineSessionNamespace(args []string) string {
+	o := lineConnectionOverrides(args)
+	if o == (connectionOverrides{}) {
+		return c.sessionNamespace()
+	}
+	name, err := c.lineContextName(args)
+	if err != nil {
+		return ""
+	}
+	key := o.key(name)
+	if key == c.context {
+		return c.sessionNamespace()
+	}
+	cc, ok := c.clients[key]
+	if !ok {
+		return ""
+	}
+	return cc.sessionNamespace
+}
+
+// previousNamespace returns the namespace which 'ns -' switches back to.
+func (c *Completer) previousNamespace() string {
+	cc, ok := c.clients[c.context]
+	if !ok {
+		return ""
+	}
+	return cc.previousNamespace
+}
+
+func (c *Completer) namespaceExists(namespace string) bool {
+	if c.namespaceList == nil {
+		// We don't have permission to list namespaces.
+		return true
+	}
+	for i := range c.namespaceList.Items {
+		if c.namespaceList.Items[i].Name == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// executeNamespaceCommand runs 'ns' command which is built in kube-prompt.
+func (e *Executor) executeNamespaceCommand(args []string) {
+	switch len(args) {
+	case 1:
+		fmt.Println(e.completer.namespace)
+	case 2:
+		if err := e.completer.setSessionNamespace(args[1]); err != nil {
+			fmt.Printf("Got error: %s\n", err.Error())
+			return
+		}
+		fmt.Printf("Namespace is changed to %q\n", e.completer.namespace)
+	default:
+		fmt.Println("Usage: ns [<namespace>|-]")
+	}
+}
+
+// namespaceArgs returns --namespace flag for the session namespace unless the
+// kubectl command specifies its namespace or targets cluster-scoped resources.
+func (e *Executor) namespaceArgs(args []string) []string {
+	namespace := e.completer.lineSessionNamespace(args)
+	if namespace == "" {
+		return nil
+	}
+	if hasNamespaceFlag(args) || e.completer.isClusterScopedCommand(args) {
+		return nil
+	}
+	return []string{"--namespace", namespace}
+}
+
+// namespaceFlag is the same as namespaceArgs, but quoted for the shell.
+func (e *Executor) namespaceFlag(args []string) string {
+	return shellFlags(e.namespaceArgs(args))
+}
+
+func hasNamespaceFlag(args []string) bool {
+	for _, a := range kubectlFlagArgs(args) {
+		switch {
+		case a == "-n", a == "--namespace", a == "-A", a == "--all-namespaces":
+			return true
+		case strings.HasPrefix(a, "--namespace="), strings.HasPrefix(a, "--all-namespaces="):
+			return true
+		case strings.HasPrefix(a, "-n") && !strings.HasPrefix(a, "--"):
+			// e.g. '-nkube-system' or '-n=kube-system'
+			return true
+		}
+	}
+	return false
+}
+
+// isClusterScopedCommand returns true if all resource types of the command
+// like 'get nodes' or 'describe pv/foo' are cluster-scoped.
+func (c *Completer) isClusterScopedCommand(args []string) bool {
+	commandArgs, _ := excludeOptions(args)
+	if len(commandArgs) < 2 {
+		return false
+	}
+	found := false
+	for _, t := range strings.Split(commandArgs[1], ",") {
+		t = strings.SplitN(t, "/", 2)[0]
+		namespaced, ok := c.isNamespacedResource(t)
+		if !ok || namespaced {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+func (c *Completer) isNamespacedResource(resourceType string) (namespaced bool, found bool) {
+	if r, ok := lookupResourceDescriptor(resourceType); ok {
+		return r.namespaced, true
+	}
+	if r, ok := lookupAPIResource(c.discoveryClient(), c.context, resourceType); ok {
+		return r.namespaced, true
+	}
+	return false, false
+}
+
+// commandNamespace returns the namespace which the command runs in.
+// It is empty for '--all-namespaces'.
+func (c *Completer) commandNamespace(args []string) string {
+	if hasFlag(args, "-A") || hasFlag(args, "--all-namespaces") {
+		return ""
+	}
+	if namespace, ok := flagValue(args, "-n", "--namespace"); ok {
+		return namespace
+	}
+	return c.namespace
+}
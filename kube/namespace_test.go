@@ -0,0 +1,101 @@
+package kube
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestShellCommandNamespace(t *testing.T) {
+	c := &Completer{
+		context:   "test-namespace",
+		namespace: "default",
+		namespaceList: &corev1.NamespaceList{Items: []corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "web"}},
+		}},
+		client:       fake.NewSimpleClientset(),
+		loadingRules: &clientcmd.ClientConfigLoadingRules{},
+		overrides:    &clientcmd.ConfigOverrides{},
+		clients: map[string]*contextClient{
+			"test-namespace": {name: "test-namespace", namespace: "default"},
+		},
+	}
+	e := NewExecutor(c, &Config{})
+
+	if actual, _ := e.shellCommand("get pods"); actual != "kubectl get pods" {
+		t.Errorf("Should not add namespace without 'ns' command, but got %s", actual)
+	}
+	if err := c.setSessionNamespace("unknown"); err == nil {
+		t.Errorf("Should be error for unknown namespace")
+	}
+	if err := c.setSessionNamespace("web"); err != nil {
+		t.Fatal(err)
+	}
+
+	var scenarioTable = []struct {
+		input    string
+		expected string
+	}{
+		{input: "get pods", expected: "kubectl get pods --namespace 'web'"},
+		{input: "get pods | grep api", expected: "kubectl get pods --namespace 'web' | grep api"},
+		{input: "get pods -n kube-system", expected: "kubectl get pods -n kube-system"},
+		{input: "get pods --namespace=kube-system", expected: "kubectl get pods --namespace=kube-system"},
+		{input: "get pods -A", expected: "kubectl get pods -A"},
+		{input: "get nodes", expected: "kubectl get nodes"},
+		{input: "describe pv/data", expected: "kubectl describe pv/data"},
+		{input: "get nodes,pods", expected: "kubectl get nodes,pods --namespace 'web'"},
+		{input: "get pods | grep -n api", expected: "kubectl get pods --namespace 'web' | grep -n api"},
+		{input: "get pods && kubectl get svc", expected: "kubectl get pods --namespace 'web' && kubectl get svc --namespace 'web'"},
+		{input: "get nodes; kubectl get nodes", expected: "kubectl get nodes; kubectl get nodes"},
+		{input: "exec api -- ls -A /", expected: "kubectl exec api --namespace 'web' -- ls -A /"},
+		{input: "exec api -- grep -n foo f", expected: "kubectl exec api --namespace 'web' -- grep -n foo f"},
+		{input: "exec api -n db -- ls", expected: "kubectl exec api -n db -- ls"},
+		{input: "get pods -o name | xargs /usr/bin/kubectl -n db describe", expected: "kubectl get pods -o name --namespace 'web' | xargs /usr/bin/kubectl -n db describe"},
+	}
+	for _, s := range scenarioTable {
+		if actual, _ := e.shellCommand(s.input); actual != s.expected {
+			t.Errorf("Should be %q, but got %q", s.expected, actual)
+		}
+	}
+
+	if err := c.setSessionNamespace("-"); err != nil {
+		t.Fatal(err)
+	}
+	if c.namespace != "default" {
+		t.Errorf("Should be switched back to default, but got %s", c.namespace)
+	}
+
+	// Any namespace is accepted when we can't list namespaces.
+	c.namespaceList = nil
+	if err := c.setSessionNamespace("x; rm -rf ~"); err != nil {
+		t.Fatal(err)
+	}
+	expected := `kubectl get pods --namespace 'x; rm -rf ~'`
+	if actual, _ := e.shellCommand("get pods"); actual != expected {
+		t.Errorf("Should be %q, but got %q", expected, actual)
+	}
+}
+
+func TestCommandNamespace(t *testing.T) {
+	c := &Completer{namespace: "web"}
+	var scenarioTable = []struct {
+		input    string
+		expected string
+	}{
+		{input: "exec api -- ls", expected: "web"},
+		{input: "exec api -n db -- ls", expected: "db"},
+		{input: "exec api -- grep -n foo f", expected: "web"},
+		{input: "exec api -- ls -A", expected: "web"},
+		{input: "get pods -A", expected: ""},
+	}
+	for _, s := range scenarioTable {
+		if actual := c.commandNamespace(strings.Fields(s.input)); actual != s.expected {
+			t.Errorf("%q: should be %q, but got %q", s.input, s.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package kube
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/c-bata/kube-prompt/internal/debug"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/kube-openapi/pkg/spec3"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+)
+
+/* OpenAPI schemas */
+
+// openAPISchemaList keeps the schemas of each group version like 'apis/apps/v1'
+// per context. They are also cached on disk per server version.
+var openAPISchemaList = new(sync.Map)
+
+// openAPISchemas are the component schemas of an OpenAPI v3 document keyed
+// by their names like 'io.k8s.api.apps.v1.Deployment'.
+type openAPISchemas map[string]*spec.Schema
+
+// openAPIPath returns the path of the group version in '/openapi/v3'.
+func openAPIPath(gv schema.GroupVersion) string {
+	if gv.Group == "" {
+		return "api/" + gv.Version
+	}
+	return "apis/" + gv.Group + "/" + gv.Version
+}
+
+// getOpenAPISchemas returns the schemas of the group version served by the
+// current context. It starts to fetch them in background and returns false
+// until they are available.
+func (c *Completer) getOpenAPISchemas(gv schema.GroupVersion) (openAPISchemas, bool) {
+	key := c.context + "/" + openAPIPath(gv)
+	if x, ok := openAPISchemaList.Load(key); ok {
+		return x.(openAPISchemas), true
+	}
+	go fetchOpenAPISchemas(c.discoveryClient(), c.context, gv)
+	return nil, false
+}
+
+func fetchOpenAPISchemas(client discovery.DiscoveryInterface, contextName string, gv schema.GroupVersion) {
+	if client == nil {
+		return
+	}
+	p := openAPIPath(gv)
+	key := "openapi_" + contextName + "/" + p
+	if !shouldFetch(key) {
+		return
+	}
+	updateLastFetchedAt(key)
+
+	v, err := client.ServerVersion()
+	if err != nil {
+		debug.Log(err.Error())
+		return
+	}
+	cachePath := openAPICachePath(contextName, v.GitVersion, p)
+	b, err := os.ReadFile(cachePath)
+	if err != nil {
+		paths, err := client.OpenAPIV3().Paths()
+		if err != nil {
+			debug.Log(err.Error())
+			return
+		}
+		g, ok := paths[p]
+		if !ok {
+			debug.Log("OpenAPI v3 schema is not served: " + p)
+			return
+		}
+		if b, err = g.Schema(runtime.ContentTypeJSON); err != nil {
+			debug.Log(err.Error())
+			return
+		}
+		writeOpenAPICache(cachePath, b)
+	}
+
+	schemas, err := parseOpenAPISchemas(b)
+	if err != nil {
+		debug.Log("failed to parse the OpenAPI schema of " + p + ": " + err.Error())
+		return
+	}
+	openAPISchemaList.Store(contextName+"/"+p, schemas)
+}
+
+func parseOpenAPISchemas(b []byte) (openAPISchemas, error) {
+	var doc spec3.OpenAPI
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Components == nil {
+		return openAPISchemas{}, nil
+	}
+	return openAPISchemas(doc.Components.Schemas), nil
+}
+
+var unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// openAPICachePath returns the path like
+// '~/.cache/kube-prompt/openapi/<context>/v1.30.3/apis_apps_v1.json'.
+// An empty string is returned if the cache directory is unknown.
+func openAPICachePath(contextName, serverVersion, p string) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "kube-prompt", "openapi",
+		unsafePathChars.ReplaceAllString(contextName, "_"),
+		unsafePathChars.ReplaceAllString(serverVersion, "_"),
+		strings.ReplaceAll(p, "/", "_")+".json")
+}
+
+func writeOpenAPICache(path string, b []byte) {
+	if path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		debug.Log(err.Error())
+		return
+	}
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		debug.Log(err.Error())
+	}
+}
+
+/* Field paths */
+
+// lookupKind finds the schema of the kind by 'x-kubernetes-group-version-kind'.
+func (s openAPISchemas) lookupKind(gvk schema.GroupVersionKind) (*spec.Schema, bool) {
+	for _, x := range s {
+		var l []struct {
+			Group   string `json:"group"`
+			Version string `json:"version"`
+			Kind    string `json:"kind"`
+		}
+		if err := x.Extensions.GetObject("x-kubernetes-group-version-kind", &l); err != nil {
+			continue
+		}
+		for i := range l {
+			if l[i].Group == gvk.Group && l[i].Version == gvk.Version && l[i].Kind == gvk.Kind {
+				return x, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// refName returns the name of the schema referred like 'io.k8s.api.apps.v1.DeploymentSpec'.
+// References are wrapped by 'allOf' when the field has its own description.
+func refName(x *spec.Schema) string {
+	if ref := x.Ref.String(); ref != "" {
+		return ref[strings.LastIndex(ref, "/")+1:]
+	}
+	if len(x.AllOf) == 1 {
+		return refName(&x.AllOf[0])
+	}
+	return ""
+}
+
+// resolve follows the reference of the schema.
+func (s openAPISchemas) resolve(x *spec.Schema) *spec.Schema {
+	for i := 0; i < 8; i++ {
+		name := refName(x)
+		if name == "" {
+			return x
+		}
+		next, ok := s[name]
+		if !ok {
+			return x
+		}
+		x = next
+	}
+	return x
+}
+
+// items returns the schema of the elements if x is an array.
+func (s openAPISchemas) items(x *spec.Schema) *spec.Schema {
+	for x.Items != nil && x.Items.Schema != nil {
+		x = s.resolve(x.Items.Schema)
+	}
+	return x
+}
+
+// lookupField walks the fields like ['spec', 'template'] from the root schema.
+// Arrays are walked through without indexes like 'containers.image', and
+// keys of maps like 'labels.app' are accepted.
+func (s openAPISchemas) lookupField(root *spec.Schema, fields []string) (*spec.Schema, bool) {
+	x := s.items(s.resolve(root))
+	for _, f := range fields {
+		if p, ok := x.Properties[f]; ok {
+			x = s.items(s.resolve(&p))
+			continue
+		}
+		if x.AdditionalProperties == nil || x.AdditionalProperties.Schema == nil {
+			return nil, false
+		}
+		x = s.items(s.resolve(x.AdditionalProperties.Schema))
+	}
+	return x, true
+}
+
+// typeName returns the type shown by 'kubectl explain' like '[]Container'.
+func (s openAPISchemas) typeName(x *spec.Schema) string {
+	if name := refName(x); name != "" {
+		return name[strings.LastIndex(name, ".")+1:]
+	}
+	if x.Items != nil && x.Items.Schema != nil {
+		return "[]" + s.typeName(x.Items.Schema)
+	}
+	if x.AdditionalProperties != nil && x.AdditionalProperties.Schema != nil {
+		return "map[string]" + s.typeName(x.AdditionalProperties.Schema)
+	}
+	if len(x.Type) > 0 {
+		return x.Type[0]
+	}
+	return "Object"
+}
+
+// fieldDescription returns the type and the first line of the documentation.
+func (s openAPISchemas) fieldDescription(x *spec.Schema) string {
+	description := x.Description
+	if description == "" {
+		description = s.resolve(x).Description
+	}
+	if i := strings.IndexByte(description, '\n'); i >= 0 {
+		description = description[:i]
+	}
+	return strings.TrimSpace("<" + s.typeName(x) + "> " + description)
+}
+
+// fieldSuggestions returns the fields of the schema prefixed by the path.
+func (s openAPISchemas) fieldSuggestions(x *spec.Schema, prefix string) []prompt.Suggest {
+	names := make([]string, 0, len(x.Properties))
+	for name := range x.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	suggests := make([]prompt.Suggest, len(names))
+	for i, name := range names {
+		p := x.Properties[name]
+		suggests[i] = prompt.Suggest{Text: prefix + name, Description: s.fieldDescription(&p)}
+	}
+	return suggests
+}
+
+var jsonPathIndex = regexp.MustCompile(`\[[^\]]*\]`)
+
+// fieldPathSuggestions suggests the fields following the path like
+// '.spec.rep', or 'status.pha' without the leading dot. Suggestions are
+// the whole paths like '.spec.replicas'. When list is true, the path may
+// start with '.items[*]' for the output of listing objects.
+func (s openAPISchemas) fieldPathSuggestions(root *spec.Schema, p string, list bool) []prompt.Suggest {
+	fields := strings.Split(jsonPathIndex.ReplaceAllString(p, ""), ".")
+	parents := fields[:len(fields)-1]
+	if len(parents) > 0 && parents[0] == "" {
+		parents = parents[1:]
+	}
+	if list && len(parents) > 0 && parents[0] == "items" {
+		parents = parents[1:]
+		list = false
+	}
+
+	x, ok := s.lookupField(root, parents)
+	if !ok {
+		return []prompt.Suggest{}
+	}
+	base := p[:strings.LastIndex(p, ".")+1]
+	suggests := s.fieldSuggestions(x, base)
+	if list && len(parents) == 0 {
+		suggests = append(suggests, prompt.Suggest{
+			Text:        base + "items",
+			Description: "<[]Object> Objects in the list",
+		})
+	}
+	return suggests
+}
+
+// commandResource returns the resource type like 'deploy' in 'get deploy web'
+// and whether the command prints a list of objects.
+func commandResource(line commandLine) (resourceType string, list bool, ok bool) {
+	commandArgs, _ := excludeOptions(line.args[:len(line.args)-1])
+	_, rest := lookupCommand(commandArgs)
+	if len(rest) == 0 {
+		return "", false, false
+	}
+	resourceType = rest[0]
+	if i := strings.IndexAny(resourceType, "/,"); i >= 0 {
+		// 'deploy/web' or 'deploy,svc'
+		return resourceType[:i], resourceType[i] == ',' || len(rest) > 1, true
+	}
+	return resourceType, len(rest) != 2, true
+}
+
+// resourceSchema returns the schema of the kind of the resource type.
+func (c *Completer) resourceSchema(resourceType string) (openAPISchemas, *spec.Schema, bool) {
+	r, ok := lookupAPIResource(c.discoveryClient(), c.context, resourceType)
+	if !ok {
+		return nil, nil, false
+	}
+	schemas, ok := c.getOpenAPISchemas(r.gvr.GroupVersion())
+	if !ok {
+		return nil, nil, false
+	}
+	root, ok := schemas.lookupKind(r.gvr.GroupVersion().WithKind(r.kind))
+	if !ok {
+		return nil, nil, false
+	}
+	return schemas, root, true
+}
+
+// fieldPathStyle is the syntax of field paths in the values of flags.
+type fieldPathStyle int
+
+const (
+	// jsonPathStyle is like '{.items[*].spec.replicas}' for the whole output of '-o jsonpath='.
+	jsonPathStyle fieldPathStyle = iota
+	// objectPathStyle is like '.spec.replicas' for each object in '-o custom-columns=' and '--sort-by'.
+	objectPathStyle
+)
+
+// getFieldPathSuggestions completes the field path starting at value[start:]
+// like '.spec.rep' in 'jsonpath={.spec.rep' for the resource type in the
+// command. Suggestions are the whole values.
+func (c *Completer) getFieldPathSuggestions(line commandLine, value string, start int, style fieldPathStyle) []prompt.Suggest {
+	resourceType, list, ok := commandResource(line)
+	if !ok {
+		return []prompt.Suggest{}
+	}
+	schemas, root, ok := c.resourceSchema(resourceType)
+	if !ok {
+		return []prompt.Suggest{}
+	}
+	p := value[start:]
+	if p == "" {
+		p = "."
+	}
+	suggests := schemas.fieldPathSuggestions(root, p, list && style == jsonPathStyle)
+	for i := range suggests {
+		suggests[i].Text = value[:start] + suggests[i].Text
+	}
+	return suggests
+}
+
+// getExplainSuggestions completes the field path of 'explain' like
+// 'deployment.spec.te'. The resource type is the first element of the path.
+func (c *Completer) getExplainSuggestions(arg string) []prompt.Suggest {
+	resourceType, p, _ := strings.Cut(arg, ".")
+	schemas, root, ok := c.resourceSchema(resourceType)
+	if !ok {
+		return []prompt.Suggest{}
+	}
+	suggests := schemas.fieldPathSuggestions(root, p, false)
+	for i := range suggests {
+		suggests[i].Text = resourceType + "." + suggests[i].Text
+	}
+	return suggests
+}
@@ -0,0 +1,148 @@
+package kube
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/c-bata/go-prompt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testOpenAPIDocument = `{
+  "openapi": "3.0.0",
+  "components": {
+    "schemas": {
+      "io.k8s.api.apps.v1.Deployment": {
+        "type": "object",
+        "properties": {
+          "metadata": {"allOf": [{"$ref": "#/components/schemas/io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta"}], "description": "Standard object's metadata."},
+          "spec": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.apps.v1.DeploymentSpec"}], "description": "Specification of the desired behavior of the Deployment."}
+        },
+        "x-kubernetes-group-version-kind": [{"group": "apps", "kind": "Deployment", "version": "v1"}]
+      },
+      "io.k8s.api.apps.v1.DeploymentSpec": {
+        "type": "object",
+        "properties": {
+          "paused": {"type": "boolean", "description": "Indicates that the deployment is paused."},
+          "replicas": {"type": "integer", "format": "int32", "description": "Number of desired pods.\nDefaults to 1."},
+          "containers": {"type": "array", "items": {"allOf": [{"$ref": "#/components/schemas/io.k8s.api.core.v1.Container"}]}}
+        }
+      },
+      "io.k8s.api.core.v1.Container": {
+        "type": "object",
+        "properties": {
+          "image": {"type": "string", "description": "Container image name."}
+        }
+      },
+      "io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta": {
+        "type": "object",
+        "properties": {
+          "labels": {"type": "object", "additionalProperties": {"type": "string", "default": ""}, "description": "Map of string keys and values."},
+          "name": {"type": "string", "description": "Name must be unique within a namespace."}
+        }
+      }
+    }
+  }
+}`
+
+func TestFieldPathSuggestions(t *testing.T) {
+	schemas, err := parseOpenAPISchemas([]byte(testOpenAPIDocument))
+	if err != nil {
+		t.Fatalf("Should be parsed, but got %s", err)
+	}
+	root, ok := schemas.lookupKind(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	if !ok {
+		t.Fatalf("Should find Deployment")
+	}
+
+	var scenarioTable = []struct {
+		path     string
+		list     bool
+		expected []prompt.Suggest
+	}{
+		{
+			path: ".spec.",
+			expected: []prompt.Suggest{
+				{Text: ".spec.containers", Description: "<[]Container>"},
+				{Text: ".spec.paused", Description: "<boolean> Indicates that the deployment is paused."},
+				{Text: ".spec.replicas", Description: "<integer> Number of desired pods."},
+			},
+		},
+		{
+			path: ".items[*].spec.containers[0].",
+			list: true,
+			expected: []prompt.Suggest{
+				{Text: ".items[*].spec.containers[0].image", Description: "<string> Container image name."},
+			},
+		},
+		{
+			path: ".",
+			list: true,
+			expected: []prompt.Suggest{
+				{Text: ".metadata", Description: "<ObjectMeta> Standard object's metadata."},
+				{Text: ".spec", Description: "<DeploymentSpec> Specification of the desired behavior of the Deployment."},
+				{Text: ".items", Description: "<[]Object> Objects in the list"},
+			},
+		},
+		{
+			path: "metadata.na",
+			expected: []prompt.Suggest{
+				{Text: "metadata.labels", Description: "<map[string]string> Map of string keys and values."},
+				{Text: "metadata.name", Description: "<string> Name must be unique within a namespace."},
+			},
+		},
+		{
+			path:     ".spec.unknown.",
+			expected: []prompt.Suggest{},
+		},
+	}
+
+	for _, s := range scenarioTable {
+		actual := schemas.fieldPathSuggestions(root, s.path, s.list)
+		if !reflect.DeepEqual(actual, s.expected) {
+			t.Errorf("%q: should be %#v, but got %#v", s.path, s.expected, actual)
+		}
+	}
+}
+
+func TestGetExplainSuggestions(t *testing.T) {
+	schemas, err := parseOpenAPISchemas([]byte(testOpenAPIDocument))
+	if err != nil {
+		t.Fatalf("Should be parsed, but got %s", err)
+	}
+	client := newTestDiscovery()
+	client.Resources = append(client.Resources, &metav1.APIResourceList{
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "deployments", SingularName: "deployment", Kind: "Deployment", Namespaced: true, ShortNames: []string{"deploy"}},
+		},
+	})
+	fetchAPIResources(client, "test-explain")
+	openAPISchemaList.Store("test-explain/apis/apps/v1", schemas)
+	c := &Completer{context: "test-explain", client: fake.NewSimpleClientset()}
+
+	var scenarioTable = []struct {
+		arg      string
+		expected []string
+	}{
+		{arg: "deploy.", expected: []string{"deploy.metadata", "deploy.spec"}},
+		{arg: "deployment.spec.containers.im", expected: []string{"deployment.spec.containers.image"}},
+		{arg: "unknown.", expected: []string{}},
+	}
+
+	for _, s := range scenarioTable {
+		actual := c.getExplainSuggestions(s.arg)
+		texts := make([]string, 0, len(actual))
+		for i := range actual {
+			if strings.HasPrefix(actual[i].Text, s.arg) {
+				texts = append(texts, actual[i].Text)
+			}
+		}
+		if !reflect.DeepEqual(texts, s.expected) {
+			t.Errorf("%q: should be %v, but got %v", s.arg, s.expected, texts)
+		}
+	}
+}
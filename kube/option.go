@@ -6,6 +6,61 @@ import (
 	prompt "github.com/c-bata/go-prompt"
 )
 
+// option is a flag of kubectl commands.
+type option struct {
+	name         string
+	shorthand    string
+	valueType    string
+	defaultValue string
+	// noOptDefault is the value used when the flag is given without its value
+	// like '--dry-run'. Such flags never consume the next argument.
+	noOptDefault string
+	deprecated   string
+	hidden       bool
+	description  string
+}
+
+// takesValue returns true if the flag consumes the next argument like '-n foo'.
+func (o option) takesValue() bool {
+	return o.valueType != "bool" && o.noOptDefault == ""
+}
+
+// lookupOption finds the flag like '--namespace' or '-n' from the flags of
+// the commands in the path. Persistent flags of parents like the global
+// flags of kubectl are also available in their subcommands.
+func lookupOption(path []*command, flag string) (option, bool) {
+	for i := len(path) - 1; i >= 0; i-- {
+		l := commandOptions(path[:i+1])
+		for j := range l {
+			if strings.HasPrefix(flag, "--") {
+				if flag[2:] == l[j].name {
+					return l[j], true
+				}
+			} else if l[j].shorthand != "" && flag[1:] == l[j].shorthand {
+				return l[j], true
+			}
+		}
+	}
+	return option{}, false
+}
+
+func optionsToSuggestions(path []*command) []prompt.Suggest {
+	var s []prompt.Suggest
+	for i := len(path) - 1; i >= 0; i-- {
+		options := commandOptions(path[:i+1])
+		for j := range options {
+			if options[j].deprecated != "" || options[j].hidden {
+				continue
+			}
+			if options[j].shorthand != "" {
+				s = append(s, prompt.Suggest{Text: "-" + options[j].shorthand, Description: options[j].description})
+			}
+			s = append(s, prompt.Suggest{Text: "--" + options[j].name, Description: options[j].description})
+		}
+	}
+	return s
+}
+
 func optionCompleter(args []string, long bool) []prompt.Suggest {
 	l := len(args)
 	if l <= 1 {
@@ -15,105 +70,12 @@ func optionCompleter(args []string, long bool) []prompt.Suggest {
 		return optionHelp
 	}
 
-	var suggests []prompt.Suggest
 	commandArgs, _ := excludeOptions(args)
-	switch commandArgs[0] {
-	case "get":
-		suggests = getOptions
-	case "describe":
-		suggests = describeOptions
-	case "create":
-		suggests = createOptions
-	case "replace":
-		suggests = replaceOptions
-	case "patch":
-		suggests = patchOptions
-	case "delete":
-		suggests = deleteOptions
-	case "edit":
-		suggests = editOptions
-	case "apply":
-		suggests = applyOptions
-	case "logs":
-		suggests = logsOptions
-	case "rolling-update":
-		suggests = rollingUpdateOptions
-	case "scale", "resize":
-		suggests = scaleOptions
-	case "attach":
-		suggests = attachOptions
-	case "exec":
-		suggests = execOptions
-	case "port-forward":
-		suggests = portForwardOptions
-	case "proxy":
-		suggests = proxyOptions
-	case "run", "run-container":
-		suggests = runOptions
-	case "expose":
-		suggests = exposeOptions
-	case "autoscale":
-		suggests = autoscaleOptions
-	case "rollout":
-		if len(commandArgs) == 2 {
-			switch commandArgs[1] {
-			case "history":
-				suggests = rolloutHistoryOptions
-			case "pause":
-				suggests = rolloutPauseOptions
-			case "resume":
-				suggests = rolloutResumeOptions
-			case "status":
-				suggests = rolloutStatusOptions
-			case "undo":
-				suggests = rolloutUndoOptions
-			}
-		}
-	case "label":
-		suggests = labelOptions
-	case "cluster-info":
-		suggests = clusterInfoOptions
-	case "explain":
-		suggests = explainOptions
-	case "cordon":
-		suggests = cordonOptions
-	case "drain":
-		suggests = drainOptions
-	case "uncordon":
-		suggests = uncordonOptions
-	case "annotate":
-		suggests = annotateOptions
-	case "convert":
-		suggests = convertOptions
-	case "top":
-		if len(commandArgs) >= 2 {
-			switch commandArgs[1] {
-			case "no", "node", "nodes":
-				suggests = topNodeOptions
-			case "po", "pod", "pods":
-				suggests = topPodOptions
-			}
-		}
-	case "config":
-		if len(commandArgs) == 2 {
-			switch commandArgs[1] {
-			case "get-contexts":
-				suggests = configGetContextsOptions
-			case "view":
-				suggests = configViewOptions
-			case "set-cluster":
-				suggests = configSetClusterOptions
-			case "set-credentials":
-				suggests = configSetCredentialsOptions
-			case "set":
-				suggests = configSetOptions
-			}
-		}
-	default:
-		suggests = optionHelp
+	path, _ := lookupCommand(commandArgs)
+	if len(path) == 1 {
+		return optionHelp
 	}
-
-	suggests = append(suggests, globalOptions...)
+	suggests := append(optionsToSuggestions(path), optionHelp...)
 	if long {
 		return prompt.FilterContains(
 			prompt.FilterHasPrefix(suggests, "--", false),
@@ -129,11 +91,55 @@ var optionHelp = []prompt.Suggest{
 	{Text: "--help"},
 }
 
-var globalOptions = []prompt.Suggest{
-	{Text: "--namespace", Description: "temporarily set the namespace for a request"},
-	{Text: "-n", Description: "temporarily set the namespace for a request"},
-	{Text: "--server", Description: "specify the address and port of the Kubernetes API server"},
-	{Text: "-s", Description: "specify the address and port of the Kubernetes API server"},
-	{Text: "--user", Description: "take the user if this flag exists."},
-	{Text: "--cluster", Description: "take the cluster if this flag exists."},
+// excludeOptions drops the flags and their values from the arguments by the
+// flag types of the command. skipNext is true if the last flag waits for its value.
+func excludeOptions(args []string) (positionals []string, skipNext bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+	positionals = make([]string, 0, len(args))
+	path := []*command{&kubectlCommand}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			// arguments passed to the container like 'exec pod -- ls -l'
+			positionals = append(positionals, args[i+1:]...)
+			break
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			positionals = append(positionals, arg)
+			path, _ = lookupCommand(positionals)
+			continue
+		}
+		if !flagTakesValue(path, arg) {
+			continue
+		}
+		if i+1 == len(args) {
+			return positionals, true
+		}
+		i++
+	}
+	return positionals, false
+}
+
+// flagTakesValue returns true if the flag like '-n' consumes the next argument.
+// It returns false if the value is given with the flag like '--namespace=foo'
+// or '-nfoo', or the flag is unknown.
+func flagTakesValue(path []*command, arg string) bool {
+	if strings.Contains(arg, "=") {
+		return false
+	}
+	if strings.HasPrefix(arg, "--") {
+		o, ok := lookupOption(path, arg)
+		return ok && o.takesValue()
+	}
+	// Shorthands can be combined like '-it'. The first one taking a value
+	// consumes the rest of the argument, or the next argument if it is the last.
+	for j := 1; j < len(arg); j++ {
+		o, ok := lookupOption(path, "-"+arg[j:j+1])
+		if ok && o.takesValue() {
+			return j == len(arg)-1
+		}
+	}
+	return false
 }
@@ -1,18 +0,0 @@
-// Code generated by 'option-gen'. DO NOT EDIT.
-
-package kube
-
-import (
-	prompt "github.com/c-bata/go-prompt"
-)
-
-var execOptions = []prompt.Suggest{
-	prompt.Suggest{Text: "-c", Description: "Container name. If omitted, the first container in the pod will be chosen"},
-	prompt.Suggest{Text: "--container", Description: "Container name. If omitted, the first container in the pod will be chosen"},
-	prompt.Suggest{Text: "-p", Description: "Pod name"},
-	prompt.Suggest{Text: "--pod", Description: "Pod name"},
-	prompt.Suggest{Text: "-i", Description: "Pass stdin to the container"},
-	prompt.Suggest{Text: "--stdin", Description: "Pass stdin to the container"},
-	prompt.Suggest{Text: "-t", Description: "Stdin is a TTY"},
-	prompt.Suggest{Text: "--tty", Description: "Stdin is a TTY"},
-}
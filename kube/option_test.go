@@ -1,6 +1,9 @@
 package kube
 
 import (
+	"context"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/c-bata/go-prompt"
@@ -52,3 +55,86 @@ func TestGetPreviousOption(t *testing.T) {
 		}
 	}
 }
+
+func TestExcludeOptions(t *testing.T) {
+	var scenarioTable = []struct {
+		input            string
+		expected         []string
+		expectedSkipNext bool
+	}{
+		{input: "get pods --field-selector status.phase=Running web", expected: []string{"get", "pods", "web"}},
+		{input: "get pods -nkube-system -w web", expected: []string{"get", "pods", "web"}},
+		{input: "--context prod get pods", expected: []string{"get", "pods"}},
+		{input: "expose deploy web --cluster-ip None --port 80", expected: []string{"expose", "deploy", "web"}},
+		{input: "logs -f web --container app", expected: []string{"logs", "web"}},
+		{input: "exec -it web -c app -- ls -l", expected: []string{"exec", "web", "ls", "-l"}},
+		{input: "rollout history deploy/web --revision 2", expected: []string{"rollout", "history", "deploy/web"}},
+		{input: "create secret generic web --from-literal key=value", expected: []string{"create", "secret", "generic", "web"}},
+		{input: "config --kubeconfig ./config view", expected: []string{"config", "view"}},
+		{input: "get pods -o", expected: []string{"get", "pods"}, expectedSkipNext: true},
+	}
+
+	for _, s := range scenarioTable {
+		actual, skipNext := excludeOptions(strings.Fields(s.input))
+		if !reflect.DeepEqual(actual, s.expected) {
+			t.Errorf("%q: should be %q, but got %q", s.input, s.expected, actual)
+		}
+		if skipNext != s.expectedSkipNext {
+			t.Errorf("%q: should be skipNext=%v, but got %v", s.input, s.expectedSkipNext, skipNext)
+		}
+	}
+}
+
+func TestLookupCommand(t *testing.T) {
+	var scenarioTable = []struct {
+		input        string
+		expectedName string
+		expectedRest []string
+	}{
+		{input: "get pods web", expectedName: "get", expectedRest: []string{"pods", "web"}},
+		{input: "rollout history deploy/web", expectedName: "rollout history", expectedRest: []string{"deploy/web"}},
+		{input: "top po web", expectedName: "top pod", expectedRest: []string{"web"}},
+		{input: "unknown", expectedName: "", expectedRest: []string{"unknown"}},
+	}
+
+	for _, s := range scenarioTable {
+		path, rest := lookupCommand(strings.Fields(s.input))
+		if name := commandName(path); name != s.expectedName {
+			t.Errorf("%q: should be %q, but got %q", s.input, s.expectedName, name)
+		}
+		if !reflect.DeepEqual(rest, s.expectedRest) {
+			t.Errorf("%q: should be %q, but got %q", s.input, s.expectedRest, rest)
+		}
+	}
+}
+
+func TestCompleteOptionValues(t *testing.T) {
+	var scenarioTable = []struct {
+		input    string
+		expected []string
+	}{
+		{input: "get pods -o ya", expected: []string{"yaml"}},
+		{input: "get pods --output=ya", expected: []string{"--output=yaml"}},
+		{input: "delete pod web --cascade=", expected: []string{"--cascade=background", "--cascade=foreground", "--cascade=orphan"}},
+		{input: "run web --image nginx --restart N", expected: []string{"Never"}},
+		{input: "patch deploy web --type ", expected: []string{"strategic", "merge", "json"}},
+	}
+
+	c := &Completer{}
+	for _, s := range scenarioTable {
+		b := prompt.NewBuffer()
+		b.InsertText(s.input, false, true)
+		actual, found := c.completeOptionArguments(context.Background(), *b.Document())
+		if !found {
+			t.Errorf("%q: should be found", s.input)
+			continue
+		}
+		texts := make([]string, len(actual))
+		for i := range actual {
+			texts[i] = actual[i].Text
+		}
+		if !reflect.DeepEqual(texts, s.expected) {
+			t.Errorf("%q: should be %q, but got %q", s.input, s.expected, texts)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package kube
+
+import (
+	"context"
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/c-bata/kube-prompt/internal/optionconv"
+)
+
+// optionValueCompleter returns the candidates for the value of a flag.
+type optionValueCompleter func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest
+
+// optionValueCompleters are keyed by the command and the flag like
+// 'patch --type', or only by the flag like '--namespace' for any command.
+// Flags not listed here are completed from "One of: (...)" in their descriptions,
+// which are also used when the completer returns nil.
+var optionValueCompleters = map[string]optionValueCompleter{
+	"--namespace": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		return getNameSpaceSuggestions(c.namespaceList)
+	},
+	"--container": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		commandArgs, _ := excludeOptions(line.args)
+		if len(commandArgs) < 2 {
+			return c.getContainerNamesFromCachedPods(c.namespace)
+		}
+		// e.g. 'exec web -c '
+		return c.getContainerName(c.namespace, commandArgs[1])
+	},
+	"--context": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		return c.getKubeconfigSuggestions("context")
+	},
+	"--cluster": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		return c.getKubeconfigSuggestions("cluster")
+	},
+	"--user": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		return c.getKubeconfigSuggestions("user")
+	},
+	// Users of RBAC are not the ones in kubeconfig.
+	"create clusterrolebinding --user": optionValues(),
+	"create rolebinding --user":        optionValues(),
+	"set subject --user":               optionValues(),
+	"--dry-run": optionValues(
+		prompt.Suggest{Text: "none", Description: "Send the request to the server"},
+		prompt.Suggest{Text: "server", Description: "Submit a server-side request without persisting the resource"},
+		prompt.Suggest{Text: "client", Description: "Only print the object that would be sent"},
+	),
+	"--cascade": optionValues(
+		prompt.Suggest{Text: "background", Description: "Delete the dependents in the background"},
+		prompt.Suggest{Text: "foreground", Description: "Delete the dependents before the owner"},
+		prompt.Suggest{Text: "orphan", Description: "Leave the dependents"},
+	),
+	"--validate": optionValues(
+		prompt.Suggest{Text: "strict", Description: "Fail the request on unknown or duplicate fields"},
+		prompt.Suggest{Text: "warn", Description: "Warn about unknown or duplicate fields"},
+		prompt.Suggest{Text: "ignore", Description: "Skip the validation"},
+	),
+	"run --restart": optionValues(
+		prompt.Suggest{Text: "Always", Description: "Create a pod which is always restarted"},
+		prompt.Suggest{Text: "OnFailure", Description: "Create a pod restarted on failure"},
+		prompt.Suggest{Text: "Never", Description: "Create a pod never restarted"},
+	),
+	"patch --type": optionValues(
+		prompt.Suggest{Text: "strategic", Description: "Strategic merge patch"},
+		prompt.Suggest{Text: "merge", Description: "JSON merge patch (RFC 7386)"},
+		prompt.Suggest{Text: "json", Description: "JSON patch (RFC 6902)"},
+	),
+	"expose --type": optionValues(serviceTypes...),
+	"explain --api-version": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		return getAPIVersionSuggestions(c.discoveryClient(), c.context)
+	},
+	"--output": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		value := line.optionValue()
+		switch {
+		case strings.HasPrefix(value, "jsonpath=") || strings.HasPrefix(value, "jsonpath-as-json="):
+			// e.g. 'jsonpath={range .items[*]}{.metadata.name}'
+			start := strings.LastIndexAny(value, "={ ") + 1
+			return c.getFieldPathSuggestions(line, value, start, jsonPathStyle)
+		case strings.HasPrefix(value, "custom-columns="):
+			// e.g. 'custom-columns=NAME:.metadata.name,IMAGE:.spec.containers[*].image'
+			start := strings.LastIndexAny(value, "=,:") + 1
+			if value[start-1] != ':' {
+				return []prompt.Suggest{}
+			}
+			return c.getFieldPathSuggestions(line, value, start, objectPathStyle)
+		}
+		return nil
+	},
+	"--sort-by": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		value := line.optionValue()
+		return c.getFieldPathSuggestions(line, value, strings.LastIndex(value, "{")+1, objectPathStyle)
+	},
+	"--selector": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		resourceType, ok := selectorResource(line)
+		if !ok {
+			return []prompt.Suggest{}
+		}
+		l := c.getLabelValues(resourceType, c.commandNamespace(line.args))
+		return labelSelectorSuggestions(l, line.optionValue())
+	},
+	"--field-selector": func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		resourceType, _, ok := commandResource(line)
+		if !ok {
+			return []prompt.Suggest{}
+		}
+		return c.fieldSelectorSuggestions(resourceType, c.commandNamespace(line.args), line.optionValue())
+	},
+}
+
+// serviceTypes are the types of Services, which are also the subcommands of 'create service'.
+var serviceTypes = []prompt.Suggest{
+	{Text: "ClusterIP", Description: "Expose the service on a cluster-internal IP"},
+	{Text: "NodePort", Description: "Expose the service on each node's IP at a static port"},
+	{Text: "LoadBalancer", Description: "Expose the service externally using a cloud provider's load balancer"},
+	{Text: "ExternalName", Description: "Map the service to the externalName field"},
+}
+
+func optionValues(values ...prompt.Suggest) optionValueCompleter {
+	return func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+		return values
+	}
+}
+
+// lookupOptionValueCompleter finds the completer for the value of the flag of
+// the command. Enumerations in the description of the flag are used unless
+// the completer is registered.
+func lookupOptionValueCompleter(path []*command, o option) (optionValueCompleter, bool) {
+	f, ok := optionValueCompleters[commandName(path)+" --"+o.name]
+	if !ok {
+		f, ok = optionValueCompleters["--"+o.name]
+	}
+	values := optionconv.EnumValues(o.description)
+	suggests := make([]prompt.Suggest, len(values))
+	for i := range values {
+		suggests[i] = prompt.Suggest{Text: values[i]}
+	}
+	switch {
+	case ok && len(values) > 0:
+		return func(ctx context.Context, c *Completer, line commandLine) []prompt.Suggest {
+			if s := f(ctx, c, line); s != nil {
+				return s
+			}
+			return suggests
+		}, true
+	case ok:
+		return f, true
+	case len(values) > 0:
+		return optionValues(suggests...), true
+	}
+	return nil, false
+}
@@ -0,0 +1,42 @@
+package kube
+
+import (
+	"github.com/c-bata/go-prompt"
+)
+
+// livePrefixColor is passed to go-prompt as the prefix text color and
+// replaced with the color for the current context by prefixColorWriter,
+// because go-prompt doesn't support changing the prefix color dynamically.
+const livePrefixColor prompt.Color = -1
+
+type prefixColorWriter struct {
+	prompt.ConsoleWriter
+	color func() prompt.Color
+}
+
+func (w *prefixColorWriter) SetColor(fg, bg prompt.Color, bold bool) {
+	if fg == livePrefixColor {
+		fg = w.color()
+	}
+	w.ConsoleWriter.SetColor(fg, bg, bold)
+}
+
+// LivePrefix returns the prompt prefix like 'prod-eu/payments >>> '.
+func (c *Completer) LivePrefix() (string, bool) {
+	return c.context + "/" + c.namespace + " >>> ", true
+}
+
+// PrefixOptions returns options of go-prompt to show the current context and
+// namespace in the prompt prefix colored by the config.
+func PrefixOptions(c *Completer, config *Config) []prompt.Option {
+	return []prompt.Option{
+		prompt.OptionLivePrefix(c.LivePrefix),
+		prompt.OptionPrefixTextColor(livePrefixColor),
+		prompt.OptionWriter(&prefixColorWriter{
+			ConsoleWriter: prompt.NewStdoutWriter(),
+			color: func() prompt.Color {
+				return config.prefixColor(c.context)
+			},
+		}),
+	}
+}
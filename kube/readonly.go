@@ -0,0 +1,203 @@
+package kube
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+)
+
+// readOnlyCommands are kubectl commands allowed in read-only mode.
+// Others like 'delete' or plugins are refused.
+var readOnlyCommands = []string{
+	"api-resources",
+	"api-versions",
+	"auth can-i",
+	"auth whoami",
+	"cluster-info",
+	"completion",
+	"config current-context",
+	"config get-clusters",
+	"config get-contexts",
+	"config get-users",
+	"config use-context",
+	"config view",
+	"describe",
+	"events",
+	"explain",
+	"get",
+	"help",
+	"logs",
+	"options",
+	"rollout history",
+	"rollout status",
+	"top",
+	"version",
+	"wait",
+}
+
+// readOnlyFilters are commands allowed after pipes in read-only mode.
+// Commands which can run other commands like 'awk', 'sed' or 'sort
+// --compress-program' are not included.
+var readOnlyFilters = []string{
+	"column",
+	"cut",
+	"egrep",
+	"fgrep",
+	"grep",
+	"head",
+	"jq",
+	"less",
+	"tail",
+	"tr",
+	"uniq",
+	"wc",
+}
+
+// readOnlyViolation returns the reason why the command line is refused in
+// read-only mode. The first command must be a read-only kubectl command, and
+// only pipes to the filters like '| grep web' may follow it.
+func readOnlyViolation(s string) (string, bool) {
+	tokens := lex(s)
+	for _, t := range tokens {
+		if t.expansion {
+			return "shell expansion like '$(...)', '`...`' or '$VAR' is not allowed", true
+		}
+		if t.redirection {
+			return "redirection like '>' or '<<<' is not allowed", true
+		}
+		if t.kind == operatorToken && t.value != "|" {
+			return fmt.Sprintf("%q is not allowed, only pipes to filters like 'grep' are", t.value), true
+		}
+	}
+	for i, command := range splitCommands(tokens) {
+		words := tokenValues(command)
+		if i == 0 {
+			name, ok := readOnlyCommand(words)
+			if name == "" {
+				return "no kubectl command is given", true
+			}
+			if !ok {
+				return name + " is not a read-only command", true
+			}
+			continue
+		}
+		if len(words) == 0 || !containsString(readOnlyFilters, words[0]) {
+			return fmt.Sprintf("%q is not allowed after pipes, only filters like 'grep' are", strings.Join(words, " ")), true
+		}
+	}
+	return "", false
+}
+
+// readOnlyCommand returns the name of the kubectl command like 'rollout undo'
+// and whether it is allowed in read-only mode. The name is empty if args
+// have no command.
+func readOnlyCommand(args []string) (string, bool) {
+	args = skipGlobalFlags(args)
+	for _, command := range readOnlyCommands {
+		if parseCommandPattern(command).match(args) {
+			return command, true
+		}
+	}
+	commandArgs, _ := excludeOptions(args)
+	path, rest := lookupCommand(commandArgs)
+	if name := commandName(path); name != "" {
+		return name, false
+	}
+	if len(rest) > 0 {
+		// plugins like 'kubectl foo'
+		return rest[0], false
+	}
+	return "", false
+}
+
+// commandWrappers run the command given as their arguments like 'xargs kubectl'.
+var commandWrappers = []string{"xargs", "env", "exec", "command", "nohup", "nice", "time", "timeout"}
+
+func containsString(l []string, s string) bool {
+	for i := range l {
+		if l[i] == s {
+			return true
+		}
+	}
+	return false
+}
+
+// kubectlCommands returns arguments of every kubectl command in the line.
+// The first command is run with kubectl by kube-prompt, and others are
+// invoked in the commands separated by pipes or other shell operators.
+func kubectlCommands(tokens []token) [][]string {
+	var result [][]string
+	for i, command := range splitCommands(tokens) {
+		if i == 0 {
+			result = append(result, tokenValues(command))
+			continue
+		}
+		result = append(result, kubectlInvocations(tokenValues(command))...)
+	}
+	return result
+}
+
+// kubectlInvocations returns arguments of kubectl commands which the shell
+// command runs, like 'xargs kubectl delete' or 'sh -c "kubectl delete ..."'.
+func kubectlInvocations(words []string) [][]string {
+	var result [][]string
+	for i := range words {
+		if path.Base(words[i]) == "kubectl" {
+			result = append(result, words[i+1:])
+			continue
+		}
+		if strings.ContainsAny(words[i], " \t\n;|&") {
+			// a script passed to 'sh -c', 'eval' and so on.
+			for _, command := range splitCommands(lex(words[i])) {
+				result = append(result, kubectlInvocations(tokenValues(command))...)
+			}
+		}
+	}
+	return result
+}
+
+// skipGlobalFlags drops the flags before the command like
+// 'kubectl --context foo delete pod bar'.
+func skipGlobalFlags(args []string) []string {
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "-") {
+			return args[i:]
+		}
+		if flagTakesValue([]*command{&kubectlCommand}, args[i]) {
+			i++
+		}
+	}
+	return nil
+}
+
+// isReadOnlySuggestion reports whether the suggested command is shown in
+// read-only mode. Commands like 'rollout' are shown if some of their
+// subcommands are read-only.
+func isReadOnlySuggestion(parent string, s prompt.Suggest) bool {
+	command := s.Text
+	if parent != "" {
+		command = parent + " " + s.Text
+	}
+	for _, c := range readOnlyCommands {
+		if c == command || strings.HasPrefix(c, command+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// filterReadOnly hides commands refused in read-only mode from suggestions.
+func (c *Completer) filterReadOnly(parent string, suggestions []prompt.Suggest) []prompt.Suggest {
+	if !c.readOnly {
+		return suggestions
+	}
+	filtered := make([]prompt.Suggest, 0, len(suggestions))
+	for i := range suggestions {
+		if isReadOnlySuggestion(parent, suggestions[i]) {
+			filtered = append(filtered, suggestions[i])
+		}
+	}
+	return filtered
+}
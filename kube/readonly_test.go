@@ -0,0 +1,78 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/c-bata/go-prompt"
+)
+
+func TestReadOnlyViolation(t *testing.T) {
+	var scenarioTable = []struct {
+		input    string
+		expected bool
+	}{
+		{input: "get pods", expected: false},
+		{input: "get pods -o name | grep web", expected: false},
+		{input: "logs -f web -c exec", expected: false},
+		{input: "rollout history deploy/web", expected: false},
+		{input: "auth can-i create pods", expected: false},
+		{input: "get pods -o jsonpath='{$.items}'", expected: false},
+		{input: "delete pod web", expected: true},
+		{input: "auth reconcile -f rbac.yaml", expected: true},
+		{input: "--context prod -n default delete pod web", expected: true},
+		{input: "rollout undo deploy/web", expected: true},
+		{input: "rollout -n default undo deploy/web", expected: true},
+		{input: "get pods -o name | xargs kubectl delete", expected: true},
+		{input: "get pods && /usr/local/bin/kubectl scale deploy/web --replicas=0", expected: true},
+		{input: "get pods;kubectl exec web -- sh", expected: true},
+		{input: "get pods | sh -c 'kubectl cordon node-1'", expected: true},
+		{input: "get pods $(kubectl delete pod web)", expected: true},
+		{input: "get pods `kubectl delete pod web`", expected: true},
+		{input: "get pods | $KUBECTL delete pod web", expected: true},
+		{input: "get pods | printf 'kub%sctl delete pod x' e | sh", expected: true},
+		{input: "get pods | printf 'kub%sctl delete pod x' e | /bin/bash -s", expected: true},
+		{input: "get pods -o name | xargs -I{} sh -c 'echo {}'", expected: true},
+		{input: "get pods && eval kubectl get pods", expected: true},
+		{input: "get pods > pods.txt; source pods.txt", expected: true},
+		{input: "get pods | grep -v sh", expected: false},
+		{input: "get pods -o json | jq '.items[].metadata.name' | head -n 3", expected: false},
+		{input: "--context prod config view", expected: false},
+		{input: "config set-context --current --namespace web", expected: true},
+		{input: "rollout status deploy/web", expected: false},
+		{input: "ns-tree default", expected: true},
+		{input: "get pods && kubectl get svc", expected: true},
+		{input: "get pods > pods.txt", expected: true},
+		{input: "get pods | sort --compress-program=sh", expected: true},
+		{input: "get pods | LESSOPEN='|sh' less", expected: true},
+		{input: "get pods | /tmp/grep web", expected: true},
+		{input: "get pods; bash<<<'kubectl delete ns prod'", expected: true},
+		{input: "get pods; ln -s /usr/local/bin/kubectl /tmp/k; /tmp/k delete ns prod", expected: true},
+		{input: `get pods; python3 -c 'import os; os.system("kube"+"ctl delete ns prod")'`, expected: true},
+	}
+
+	for _, s := range scenarioTable {
+		_, actual := readOnlyViolation(s.input)
+		if actual != s.expected {
+			t.Errorf("%q should be refused=%v, but got %v", s.input, s.expected, actual)
+		}
+	}
+}
+
+func TestIsReadOnlySuggestion(t *testing.T) {
+	var scenarioTable = []struct {
+		parent   string
+		text     string
+		expected bool
+	}{
+		{parent: "", text: "get", expected: true},
+		{parent: "", text: "rollout", expected: true},
+		{parent: "", text: "delete", expected: false},
+		{parent: "rollout", text: "history", expected: true},
+		{parent: "rollout", text: "undo", expected: false},
+	}
+	for _, s := range scenarioTable {
+		if actual := isReadOnlySuggestion(s.parent, prompt.Suggest{Text: s.text}); actual != s.expected {
+			t.Errorf("%q %q should be shown=%v, but got %v", s.parent, s.text, s.expected, actual)
+		}
+	}
+}
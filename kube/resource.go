@@ -6,16 +6,27 @@ import (
 	"sort"
 	"strings"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/c-bata/go-prompt"
 	"github.com/c-bata/kube-prompt/internal/debug"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -23,22 +34,6 @@ const thresholdFetchInterval = 10 * time.Second
 
 func init() {
 	lastFetchedAt = new(sync.Map)
-	podList = new(sync.Map)
-	endpointList = new(sync.Map)
-	deploymentList = new(sync.Map)
-	daemonSetList = new(sync.Map)
-	eventList = new(sync.Map)
-	secretList = new(sync.Map)
-	ingressList = new(sync.Map)
-	limitRangeList = new(sync.Map)
-	persistentVolumeClaimsList = new(sync.Map)
-	podTemplateList = new(sync.Map)
-	replicaSetList = new(sync.Map)
-	replicationControllerList = new(sync.Map)
-	resourceQuotaList = new(sync.Map)
-	serviceAccountList = new(sync.Map)
-	serviceList = new(sync.Map)
-	jobList = new(sync.Map)
 }
 
 /* LastFetchedAt */
@@ -48,6 +43,10 @@ var (
 )
 
 func shouldFetch(key string) bool {
+	return shouldFetchWithInterval(key, thresholdFetchInterval)
+}
+
+func shouldFetchWithInterval(key string, interval time.Duration) bool {
 	v, ok := lastFetchedAt.Load(key)
 	if !ok {
 		return true
@@ -56,163 +55,582 @@ func shouldFetch(key string) bool {
 	if !ok {
 		return true
 	}
-	return time.Since(t) > thresholdFetchInterval
+	return time.Since(t) > interval
 }
 
 func updateLastFetchedAt(key string) {
 	lastFetchedAt.Store(key, time.Now())
 }
 
-/* Component Status */
+/* Resource descriptors */
+
+// resourceDescriptor describes a built-in resource type and how to turn
+// its objects into suggestions. Objects are listed only with their metadata.
+type resourceDescriptor struct {
+	// gvr is the latest version. The version served by the cluster is chosen
+	// through discovery, also from legacyGroups like 'extensions' for Ingress.
+	gvr          schema.GroupVersionResource
+	legacyGroups []string
+	namespaced   bool
+	names        []string
+	suggest      func(obj interface{}) prompt.Suggest
+}
+
+func nameSuggest(obj interface{}) prompt.Suggest {
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return prompt.Suggest{}
+	}
+	return prompt.Suggest{Text: o.GetName()}
+}
 
 var (
-	componentStatusList atomic.Value
+	certificateSigningRequestResource = &resourceDescriptor{
+		gvr:     certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+		names:   []string{"certificatesigningrequests", "certificatesigningrequest", "csr"},
+		suggest: nameSuggest,
+	}
+	clusterRoleBindingResource = &resourceDescriptor{
+		gvr:     rbacv1.SchemeGroupVersion.WithResource("clusterrolebindings"),
+		names:   []string{"clusterrolebindings", "clusterrolebinding"},
+		suggest: nameSuggest,
+	}
+	clusterRoleResource = &resourceDescriptor{
+		gvr:     rbacv1.SchemeGroupVersion.WithResource("clusterroles"),
+		names:   []string{"clusterroles", "clusterrole"},
+		suggest: nameSuggest,
+	}
+	componentStatusResource = &resourceDescriptor{
+		gvr:     corev1.SchemeGroupVersion.WithResource("componentstatuses"),
+		names:   []string{"componentstatuses", "componentstatus", "cs"},
+		suggest: nameSuggest,
+	}
+	configMapResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("configmaps"),
+		namespaced: true,
+		names:      []string{"configmaps", "configmap", "cm"},
+		suggest:    nameSuggest,
+	}
+	controllerRevisionResource = &resourceDescriptor{
+		gvr:        appsv1.SchemeGroupVersion.WithResource("controllerrevisions"),
+		namespaced: true,
+		names:      []string{"controllerrevisions", "controllerrevision"},
+		suggest:    nameSuggest,
+	}
+	cronJobResource = &resourceDescriptor{
+		gvr:        batchv1.SchemeGroupVersion.WithResource("cronjobs"),
+		namespaced: true,
+		names:      []string{"cronjobs", "cronjob", "cj"},
+		suggest:    nameSuggest,
+	}
+	csiDriverResource = &resourceDescriptor{
+		gvr:     storagev1.SchemeGroupVersion.WithResource("csidrivers"),
+		names:   []string{"csidrivers", "csidriver"},
+		suggest: nameSuggest,
+	}
+	csiNodeResource = &resourceDescriptor{
+		gvr:     storagev1.SchemeGroupVersion.WithResource("csinodes"),
+		names:   []string{"csinodes", "csinode"},
+		suggest: nameSuggest,
+	}
+	csiStorageCapacityResource = &resourceDescriptor{
+		gvr:        storagev1.SchemeGroupVersion.WithResource("csistoragecapacities"),
+		namespaced: true,
+		names:      []string{"csistoragecapacities", "csistoragecapacity"},
+		suggest:    nameSuggest,
+	}
+	daemonSetResource = &resourceDescriptor{
+		gvr:        appsv1.SchemeGroupVersion.WithResource("daemonsets"),
+		namespaced: true,
+		names:      []string{"daemonsets", "daemonset", "ds"},
+		suggest:    nameSuggest,
+	}
+	deploymentResource = &resourceDescriptor{
+		gvr:        appsv1.SchemeGroupVersion.WithResource("deployments"),
+		namespaced: true,
+		names:      []string{"deployments", "deployment", "deploy"},
+		suggest:    nameSuggest,
+	}
+	endpointSliceResource = &resourceDescriptor{
+		gvr:        discoveryv1.SchemeGroupVersion.WithResource("endpointslices"),
+		namespaced: true,
+		names:      []string{"endpointslices", "endpointslice"},
+		suggest:    nameSuggest,
+	}
+	endpointsResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("endpoints"),
+		namespaced: true,
+		names:      []string{"endpoints", "ep"},
+		suggest:    nameSuggest,
+	}
+	eventResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("events"),
+		namespaced: true,
+		names:      []string{"events", "event", "ev"},
+		suggest:    nameSuggest,
+	}
+	horizontalPodAutoscalerResource = &resourceDescriptor{
+		gvr:        autoscalingv2.SchemeGroupVersion.WithResource("horizontalpodautoscalers"),
+		namespaced: true,
+		names:      []string{"horizontalpodautoscalers", "horizontalpodautoscaler", "hpa"},
+		suggest:    nameSuggest,
+	}
+	ingressClassResource = &resourceDescriptor{
+		gvr:     networkingv1.SchemeGroupVersion.WithResource("ingressclasses"),
+		names:   []string{"ingressclasses", "ingressclass"},
+		suggest: nameSuggest,
+	}
+	ingressResource = &resourceDescriptor{
+		gvr:          networkingv1.SchemeGroupVersion.WithResource("ingresses"),
+		legacyGroups: []string{extensionsv1beta1.GroupName},
+		namespaced:   true,
+		names:        []string{"ingresses", "ingress", "ing"},
+		suggest:      nameSuggest,
+	}
+	jobResource = &resourceDescriptor{
+		gvr:        batchv1.SchemeGroupVersion.WithResource("jobs"),
+		namespaced: true,
+		names:      []string{"jobs", "job"},
+		suggest: func(obj interface{}) prompt.Suggest {
+			o, err := meta.Accessor(obj)
+			if err != nil {
+				return prompt.Suggest{}
+			}
+			return prompt.Suggest{
+				Text:        o.GetName(),
+				Description: o.GetCreationTimestamp().String(),
+			}
+		},
+	}
+	leaseResource = &resourceDescriptor{
+		gvr:        coordinationv1.SchemeGroupVersion.WithResource("leases"),
+		namespaced: true,
+		names:      []string{"leases", "lease"},
+		suggest:    nameSuggest,
+	}
+	limitRangeResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("limitranges"),
+		namespaced: true,
+		names:      []string{"limitranges", "limitrange", "limits"},
+		suggest:    nameSuggest,
+	}
+	mutatingWebhookConfigurationResource = &resourceDescriptor{
+		gvr:     admissionregistrationv1.SchemeGroupVersion.WithResource("mutatingwebhookconfigurations"),
+		names:   []string{"mutatingwebhookconfigurations", "mutatingwebhookconfiguration"},
+		suggest: nameSuggest,
+	}
+	namespaceResource = &resourceDescriptor{
+		gvr:     corev1.SchemeGroupVersion.WithResource("namespaces"),
+		names:   []string{"namespaces", "namespace", "ns"},
+		suggest: nameSuggest,
+	}
+	networkPolicyResource = &resourceDescriptor{
+		gvr:        networkingv1.SchemeGroupVersion.WithResource("networkpolicies"),
+		namespaced: true,
+		names:      []string{"networkpolicies", "networkpolicy", "netpol"},
+		suggest:    nameSuggest,
+	}
+	nodeResource = &resourceDescriptor{
+		gvr:     corev1.SchemeGroupVersion.WithResource("nodes"),
+		names:   []string{"nodes", "node", "no"},
+		suggest: nameSuggest,
+	}
+	persistentVolumeClaimResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("persistentvolumeclaims"),
+		namespaced: true,
+		names:      []string{"persistentvolumeclaims", "persistentvolumeclaim", "pvc"},
+		suggest:    nameSuggest,
+	}
+	persistentVolumeResource = &resourceDescriptor{
+		gvr:     corev1.SchemeGroupVersion.WithResource("persistentvolumes"),
+		names:   []string{"persistentvolumes", "persistentvolume", "pv"},
+		suggest: nameSuggest,
+	}
+	podDisruptionBudgetResource = &resourceDescriptor{
+		gvr:        policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"),
+		namespaced: true,
+		names:      []string{"poddisruptionbudgets", "poddisruptionbudget", "pdb"},
+		suggest:    nameSuggest,
+	}
+	podResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("pods"),
+		namespaced: true,
+		names:      []string{"pods", "pod", "po"},
+		suggest:    nameSuggest,
+	}
+	podTemplateResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("podtemplates"),
+		namespaced: true,
+		names:      []string{"podtemplates", "podtemplate"},
+		suggest:    nameSuggest,
+	}
+	priorityClassResource = &resourceDescriptor{
+		gvr:     schedulingv1.SchemeGroupVersion.WithResource("priorityclasses"),
+		names:   []string{"priorityclasses", "priorityclass", "pc"},
+		suggest: nameSuggest,
+	}
+	replicaSetResource = &resourceDescriptor{
+		gvr:        appsv1.SchemeGroupVersion.WithResource("replicasets"),
+		namespaced: true,
+		names:      []string{"replicasets", "replicaset", "rs"},
+		suggest:    nameSuggest,
+	}
+	replicationControllerResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("replicationcontrollers"),
+		namespaced: true,
+		names:      []string{"replicationcontrollers", "replicationcontroller", "rc"},
+		suggest:    nameSuggest,
+	}
+	resourceQuotaResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("resourcequotas"),
+		namespaced: true,
+		names:      []string{"resourcequotas", "resourcequota", "quota"},
+		suggest:    nameSuggest,
+	}
+	roleBindingResource = &resourceDescriptor{
+		gvr:        rbacv1.SchemeGroupVersion.WithResource("rolebindings"),
+		namespaced: true,
+		names:      []string{"rolebindings", "rolebinding"},
+		suggest:    nameSuggest,
+	}
+	roleResource = &resourceDescriptor{
+		gvr:        rbacv1.SchemeGroupVersion.WithResource("roles"),
+		namespaced: true,
+		names:      []string{"roles", "role"},
+		suggest:    nameSuggest,
+	}
+	secretResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("secrets"),
+		namespaced: true,
+		names:      []string{"secrets", "secret"},
+		suggest:    nameSuggest,
+	}
+	serviceAccountResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("serviceaccounts"),
+		namespaced: true,
+		names:      []string{"serviceaccounts", "serviceaccount", "sa"},
+		suggest:    nameSuggest,
+	}
+	serviceResource = &resourceDescriptor{
+		gvr:        corev1.SchemeGroupVersion.WithResource("services"),
+		namespaced: true,
+		names:      []string{"services", "service", "svc"},
+		suggest:    nameSuggest,
+	}
+	statefulSetResource = &resourceDescriptor{
+		gvr:        appsv1.SchemeGroupVersion.WithResource("statefulsets"),
+		namespaced: true,
+		names:      []string{"statefulsets", "statefulset", "sts"},
+		suggest:    nameSuggest,
+	}
+	storageClassResource = &resourceDescriptor{
+		gvr:     storagev1.SchemeGroupVersion.WithResource("storageclasses"),
+		names:   []string{"storageclasses", "storageclass", "sc"},
+		suggest: nameSuggest,
+	}
+	validatingAdmissionPolicyBindingResource = &resourceDescriptor{
+		gvr:     admissionregistrationv1.SchemeGroupVersion.WithResource("validatingadmissionpolicybindings"),
+		names:   []string{"validatingadmissionpolicybindings", "validatingadmissionpolicybinding"},
+		suggest: nameSuggest,
+	}
+	validatingAdmissionPolicyResource = &resourceDescriptor{
+		gvr:     admissionregistrationv1.SchemeGroupVersion.WithResource("validatingadmissionpolicies"),
+		names:   []string{"validatingadmissionpolicies", "validatingadmissionpolicy"},
+		suggest: nameSuggest,
+	}
+	validatingWebhookConfigurationResource = &resourceDescriptor{
+		gvr:     admissionregistrationv1.SchemeGroupVersion.WithResource("validatingwebhookconfigurations"),
+		names:   []string{"validatingwebhookconfigurations", "validatingwebhookconfiguration"},
+		suggest: nameSuggest,
+	}
+	volumeAttachmentResource = &resourceDescriptor{
+		gvr:     storagev1.SchemeGroupVersion.WithResource("volumeattachments"),
+		names:   []string{"volumeattachments", "volumeattachment"},
+		suggest: nameSuggest,
+	}
 )
 
-func fetchComponentStatusList(ctx context.Context, client *kubernetes.Clientset) {
-	key := "component_status"
-	if !shouldFetch(key) {
-		return
+var resourceDescriptors = []*resourceDescriptor{
+	certificateSigningRequestResource,
+	clusterRoleBindingResource,
+	clusterRoleResource,
+	componentStatusResource,
+	configMapResource,
+	controllerRevisionResource,
+	cronJobResource,
+	csiDriverResource,
+	csiNodeResource,
+	csiStorageCapacityResource,
+	daemonSetResource,
+	deploymentResource,
+	endpointSliceResource,
+	endpointsResource,
+	eventResource,
+	horizontalPodAutoscalerResource,
+	ingressClassResource,
+	ingressResource,
+	jobResource,
+	leaseResource,
+	limitRangeResource,
+	mutatingWebhookConfigurationResource,
+	namespaceResource,
+	networkPolicyResource,
+	nodeResource,
+	persistentVolumeClaimResource,
+	persistentVolumeResource,
+	podDisruptionBudgetResource,
+	podResource,
+	podTemplateResource,
+	priorityClassResource,
+	replicaSetResource,
+	replicationControllerResource,
+	resourceQuotaResource,
+	roleBindingResource,
+	roleResource,
+	secretResource,
+	serviceAccountResource,
+	serviceResource,
+	statefulSetResource,
+	storageClassResource,
+	validatingAdmissionPolicyBindingResource,
+	validatingAdmissionPolicyResource,
+	validatingWebhookConfigurationResource,
+	volumeAttachmentResource,
+}
+
+func lookupResourceDescriptor(resourceType string) (*resourceDescriptor, bool) {
+	resourceType = strings.ToLower(resourceType)
+	for _, r := range resourceDescriptors {
+		for _, n := range r.names {
+			if n == resourceType {
+				return r, true
+			}
+		}
+		for _, group := range append([]string{r.gvr.Group}, r.legacyGroups...) {
+			if group != "" && r.gvr.Resource+"."+group == resourceType {
+				return r, true
+			}
+		}
 	}
-	l, _ := client.CoreV1().ComponentStatuses().List(ctx, metav1.ListOptions{})
-	componentStatusList.Store(l)
-	updateLastFetchedAt(key)
+	return nil, false
 }
 
-func getComponentStatusCompletions(ctx context.Context, client *kubernetes.Clientset) []prompt.Suggest {
-	go fetchComponentStatusList(ctx, client)
-	l, ok := componentStatusList.Load().(*corev1.ComponentStatusList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
+/* Cached objects */
+
+// servedGVR returns the version of the resource served by the cluster like
+// batch/v1beta1 for CronJobs in old clusters. The latest one is returned until
+// the discovery is finished.
+func (c *Completer) servedGVR(r *resourceDescriptor) schema.GroupVersionResource {
+	l := getAPIResources(c.discoveryClient(), c.context)
+	for _, group := range append([]string{r.gvr.Group}, r.legacyGroups...) {
+		for i := range l {
+			if l[i].gvr.Group == group && l[i].gvr.Resource == r.gvr.Resource {
+				return l[i].gvr
+			}
 		}
 	}
-	return s
+	return r.gvr
 }
 
-/* Config Maps */
+func (c *Completer) objectCacheKey(gvr schema.GroupVersionResource, namespaced bool, namespace string) objectCacheKey {
+	if !namespaced {
+		namespace = ""
+	}
+	return objectCacheKey{context: c.context, gvr: gvr, namespace: namespace}
+}
 
-var (
-	configMapsList atomic.Value
-)
+func (c *Completer) listObjects(r *resourceDescriptor, namespace string) []interface{} {
+	gvr := c.servedGVR(r)
+	key := c.objectCacheKey(gvr, r.namespaced, namespace)
+	return objects.list(key, newMetadataInformer(c.metadataClient, gvr, key.namespace))
+}
 
-func fetchConfigMapList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "config_map_" + namespace
-	if !shouldFetch(key) {
-		return
+func (c *Completer) getObjectSuggestions(r *resourceDescriptor, namespace string) []prompt.Suggest {
+	suggests := objectsToSuggestions(c.listObjects(r, namespace), r.suggest)
+	if r == podResource {
+		c.describePodPhases(suggests, namespace)
 	}
-	updateLastFetchedAt(key)
-	l, _ := client.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
-	configMapsList.Store(l)
+	return suggests
 }
 
-func getConfigMapSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchConfigMapList(ctx, client, namespace)
-	l, ok := configMapsList.Load().(*corev1.ConfigMapList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
+// listObjectsOf returns the cached objects of the resource type typed by users.
+func (c *Completer) listObjectsOf(resourceType string, namespace string) []interface{} {
+	if r, ok := lookupResourceDescriptor(resourceType); ok {
+		return c.listObjects(r, namespace)
 	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
+	r, ok := lookupAPIResource(c.discoveryClient(), c.context, resourceType)
+	if !ok {
+		return nil
+	}
+	key := c.objectCacheKey(r.gvr, r.namespaced, namespace)
+	return objects.list(key, newMetadataInformer(c.metadataClient, r.gvr, key.namespace))
+}
+
+// listSyncedObjectsOf returns the cached objects of the resource type typed by users
+// after waiting for the first list of the informer.
+func (c *Completer) listSyncedObjectsOf(resourceType string, namespace string) ([]metav1.Object, bool) {
+	var l []interface{}
+	if r, ok := lookupResourceDescriptor(resourceType); ok {
+		gvr := c.servedGVR(r)
+		key := c.objectCacheKey(gvr, r.namespaced, namespace)
+		l, ok = objects.listSynced(key, newMetadataInformer(c.metadataClient, gvr, key.namespace), waitForSyncTimeout)
+		if !ok {
+			return nil, false
 		}
+	} else if r, ok := lookupAPIResource(c.discoveryClient(), c.context, resourceType); ok {
+		key := c.objectCacheKey(r.gvr, r.namespaced, namespace)
+		l, ok = objects.listSynced(key, newMetadataInformer(c.metadataClient, r.gvr, key.namespace), waitForSyncTimeout)
+		if !ok {
+			return nil, false
+		}
+	} else {
+		return nil, false
 	}
-	return s
-}
-
-/* Contexts */
-
-var (
-	contextList atomic.Value
-)
 
-func fetchContextList() {
-	key := "context"
-	if !shouldFetch(key) {
-		return
+	result := make([]metav1.Object, 0, len(l))
+	for i := range l {
+		if o, err := meta.Accessor(l[i]); err == nil {
+			result = append(result, o)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].GetNamespace() != result[j].GetNamespace() {
+			return result[i].GetNamespace() < result[j].GetNamespace()
+		}
+		return result[i].GetName() < result[j].GetName()
+	})
+	return result, true
+}
+
+// getObjectMetadataSuggestions lists objects of any resource types like
+// CustomResources only with their metadata.
+func (c *Completer) getObjectMetadataSuggestions(r apiResource, namespace string) []prompt.Suggest {
+	key := c.objectCacheKey(r.gvr, r.namespaced, namespace)
+	l := objects.list(key, newMetadataInformer(c.metadataClient, r.gvr, key.namespace))
+	return objectsToSuggestions(l, func(obj interface{}) prompt.Suggest {
+		s := nameSuggest(obj)
+		s.Description = r.kind
+		return s
+	})
+}
+
+/* Full objects */
+
+// fullObjectLimit is the number of objects fetched with their specs, like
+// containers of pods. Only the metadata of all objects is cached, because
+// listing full objects is too expensive in large clusters.
+const fullObjectLimit = 500
+
+// fullObjectList keeps the first objects of pods and events per context
+// and namespace like 'prod/pods/default'.
+var fullObjectList = new(sync.Map)
+
+// listFullObjects returns some of the pods or events with their specs. It starts
+// to fetch them in background and returns nothing until they are available.
+func (c *Completer) listFullObjects(r *resourceDescriptor, namespace string) []interface{} {
+	key := c.context + "/" + r.gvr.Resource + "/" + namespace
+	go fetchFullObjects(c.client, key, r, namespace)
+	x, ok := fullObjectList.Load(key)
+	if !ok {
+		return nil
 	}
-	updateLastFetchedAt(key)
-	r := ExecuteAndGetResult("config get-contexts --no-headers -o name")
-	r = strings.TrimRight(r, "\n")
-	contextList.Store(strings.Split(r, "\n"))
+	return x.([]interface{})
 }
 
-func getContextSuggestions() []prompt.Suggest {
-	go fetchContextList()
-	l, ok := contextList.Load().([]string)
-	if !ok || len(l) == 0 {
-		return []prompt.Suggest{}
+func fetchFullObjects(client kubernetes.Interface, key string, r *resourceDescriptor, namespace string) {
+	if client == nil {
+		return
 	}
-	s := make([]prompt.Suggest, len(l))
-	for i := range l {
-		s[i] = prompt.Suggest{
-			Text: l[i],
+	if !shouldFetch("full_objects_" + key) {
+		return
+	}
+	updateLastFetchedAt("full_objects_" + key)
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForSyncTimeout)
+	defer cancel()
+	opts := metav1.ListOptions{Limit: fullObjectLimit}
+	var l []interface{}
+	switch r {
+	case podResource:
+		pods, err := client.CoreV1().Pods(namespace).List(ctx, opts)
+		if err != nil {
+			debug.Log(err.Error())
+			return
+		}
+		for i := range pods.Items {
+			l = append(l, &pods.Items[i])
 		}
+	case eventResource:
+		events, err := client.CoreV1().Events(namespace).List(ctx, opts)
+		if err != nil {
+			debug.Log(err.Error())
+			return
+		}
+		for i := range events.Items {
+			l = append(l, &events.Items[i])
+		}
+	default:
+		return
 	}
-	return s
+	fullObjectList.Store(key, l)
 }
 
 /* Pod */
 
-var (
-	podList *sync.Map
-)
-
-func fetchPods(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "pod_" + namespace
-	if !shouldFetch(key) {
+// describePodPhases sets the phases of the pods like 'Running' to the
+// descriptions. Phases are known only for the pods fetched with their
+// statuses by listFullObjects.
+func (c *Completer) describePodPhases(suggests []prompt.Suggest, namespace string) {
+	phases := make(map[string]string)
+	for _, obj := range c.listFullObjects(podResource, namespace) {
+		if p, ok := obj.(*corev1.Pod); ok {
+			phases[p.Name] = string(p.Status.Phase)
+		}
+	}
+	if len(phases) == 0 {
 		return
 	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
-	podList.Store(namespace, l)
+	for i := range suggests {
+		suggests[i].Description = phases[suggests[i].Text]
+	}
 }
 
-func getPodSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchPods(ctx, client, namespace)
-	x, ok := podList.Load(namespace)
+// podList keeps the pods fetched by their names like 'prod/default/web-1'.
+var podList = new(sync.Map)
+
+// getPod returns the pod with its spec. It starts to fetch the pod in
+// background and returns false until it is available.
+func (c *Completer) getPod(namespace, podName string) (*corev1.Pod, bool) {
+	key := c.context + "/" + namespace + "/" + podName
+	go fetchPod(c.client, key, namespace, podName)
+	x, ok := podList.Load(key)
 	if !ok {
-		return []prompt.Suggest{}
+		return nil, false
 	}
-	l, ok := x.(*corev1.PodList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text:        l.Items[i].Name,
-			Description: string(l.Items[i].Status.Phase),
-		}
-	}
-	return s
+	return x.(*corev1.Pod), true
 }
 
-func getPod(namespace, podName string) (corev1.Pod, bool) {
-	x, ok := podList.Load(namespace)
-	if !ok {
-		return corev1.Pod{}, false
+func fetchPod(client kubernetes.Interface, key, namespace, podName string) {
+	if client == nil {
+		return
 	}
-	l, ok := x.(*corev1.PodList)
-	if !ok || len(l.Items) == 0 {
-		return corev1.Pod{}, false
+	if !shouldFetch("pod_" + key) {
+		return
 	}
-	for i := range l.Items {
-		if podName == l.Items[i].Name {
-			return l.Items[i], true
-		}
+	updateLastFetchedAt("pod_" + key)
+
+	ctx, cancel := context.WithTimeout(context.Background(), waitForSyncTimeout)
+	defer cancel()
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		debug.Log(err.Error())
+		return
 	}
-	return corev1.Pod{}, false
+	podList.Store(key, pod)
 }
 
-func getPortsFromPodName(namespace string, podName string) []prompt.Suggest {
-	pod, found := getPod(namespace, podName)
+func (c *Completer) getPortsFromPodName(namespace string, podName string) []prompt.Suggest {
+	pod, found := c.getPod(namespace, podName)
 	if !found {
 		return []prompt.Suggest{}
 	}
@@ -243,22 +661,20 @@ func getPortsFromPodName(namespace string, podName string) []prompt.Suggest {
 	return suggests
 }
 
-func getContainerNamesFromCachedPods(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchPods(ctx, client, namespace)
-
-	x, ok := podList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.PodList)
-	if !ok || len(l.Items) == 0 {
+func (c *Completer) getContainerNamesFromCachedPods(namespace string) []prompt.Suggest {
+	l := c.listFullObjects(podResource, namespace)
+	if len(l) == 0 {
 		return []prompt.Suggest{}
 	}
 	// container name -> pod name
-	set := make(map[string]string, len(l.Items))
-	for i := range l.Items {
-		for j := range l.Items[i].Spec.Containers {
-			set[l.Items[i].Spec.Containers[j].Name] = l.Items[i].Name
+	set := make(map[string]string, len(l))
+	for i := range l {
+		pod, ok := l[i].(*corev1.Pod)
+		if !ok {
+			continue
+		}
+		for j := range pod.Spec.Containers {
+			set[pod.Spec.Containers[j].Name] = pod.Name
 		}
 	}
 	s := make([]prompt.Suggest, 0, len(set))
@@ -271,10 +687,8 @@ func getContainerNamesFromCachedPods(ctx context.Context, client *kubernetes.Cli
 	return s
 }
 
-func getContainerName(ctx context.Context, client *kubernetes.Clientset, namespace string, podName string) []prompt.Suggest {
-	go fetchPods(ctx, client, namespace)
-
-	pod, found := getPod(namespace, podName)
+func (c *Completer) getContainerName(namespace string, podName string) []prompt.Suggest {
+	pod, found := c.getPod(namespace, podName)
 	if !found {
 		return []prompt.Suggest{}
 	}
@@ -288,302 +702,6 @@ func getContainerName(ctx context.Context, client *kubernetes.Clientset, namespa
 	return s
 }
 
-/* Daemon Sets */
-
-var (
-	daemonSetList *sync.Map
-)
-
-func fetchDaemonSetList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "daemon_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
-	daemonSetList.Store(namespace, l)
-	return
-}
-
-func getDaemonSetSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchDaemonSetList(ctx, client, namespace)
-	x, ok := daemonSetList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(appsv1.DaemonSetList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Deployment */
-
-var (
-	deploymentList *sync.Map
-)
-
-func fetchDeployments(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "deployment_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
-	deploymentList.Store(namespace, l)
-	return
-}
-
-func getDeploymentSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchDeployments(ctx, client, namespace)
-	x, ok := deploymentList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*appsv1.DeploymentList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Endpoint */
-
-var (
-	endpointList *sync.Map
-)
-
-func fetchEndpoints(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "endpoint_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().Endpoints(namespace).List(ctx, metav1.ListOptions{})
-	endpointList.Store(key, l)
-	return
-}
-
-func getEndpointsSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchEndpoints(ctx, client, namespace)
-	x, ok := endpointList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.EndpointsList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Events */
-
-var (
-	eventList *sync.Map
-)
-
-func fetchEvents(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "event_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{})
-	eventList.Store(namespace, l)
-	return
-}
-
-func getEventsSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchEvents(ctx, client, namespace)
-	x, ok := eventList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.EventList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Node */
-
-var (
-	nodeList atomic.Value
-)
-
-func fetchNodeList(ctx context.Context, client *kubernetes.Clientset) {
-	key := "node"
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
-	nodeList.Store(l)
-	return
-}
-
-func getNodeSuggestions(ctx context.Context, client *kubernetes.Clientset) []prompt.Suggest {
-	go fetchNodeList(ctx, client)
-	l, ok := nodeList.Load().(*corev1.NodeList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Secret */
-
-var (
-	secretList *sync.Map
-)
-
-func fetchSecretList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "secret_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
-	secretList.Store(namespace, l)
-	return
-}
-
-func getSecretSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchSecretList(ctx, client, namespace)
-	x, ok := secretList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.SecretList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Ingress */
-
-var (
-	ingressList *sync.Map
-)
-
-func fetchIngresses(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "ingress_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.ExtensionsV1beta1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
-	ingressList.Store(namespace, l)
-}
-
-func getIngressSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchIngresses(ctx, client, namespace)
-
-	x, ok := ingressList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*extensionsv1beta1.IngressList)
-	if !ok {
-		debug.Log("must not reach here")
-		return []prompt.Suggest{}
-	}
-	if len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* LimitRange */
-
-var (
-	limitRangeList *sync.Map
-)
-
-func fetchLimitRangeList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "limit_range_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().LimitRanges(namespace).List(ctx, metav1.ListOptions{})
-	limitRangeList.Store(namespace, l)
-	return
-}
-
-func getLimitRangeSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchLimitRangeList(ctx, client, namespace)
-	x, ok := limitRangeList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.NamespaceList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
 /* NameSpaces */
 
 func getNameSpaceSuggestions(namespaceList *corev1.NamespaceList) []prompt.Suggest {
@@ -598,332 +716,3 @@ func getNameSpaceSuggestions(namespaceList *corev1.NamespaceList) []prompt.Sugge
 	}
 	return s
 }
-
-/* Persistent Volume Claims */
-
-var (
-	persistentVolumeClaimsList *sync.Map
-)
-
-func fetchPersistentVolumeClaimsList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "persistent_volume_claims" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
-	persistentVolumeClaimsList.Store(namespace, l)
-	return
-}
-
-func getPersistentVolumeClaimSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchPersistentVolumeClaimsList(ctx, client, namespace)
-	x, ok := persistentVolumeClaimsList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.PersistentVolumeClaimList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Persistent Volumes */
-
-var (
-	persistentVolumesList atomic.Value
-)
-
-func fetchPersistentVolumeList(ctx context.Context, client *kubernetes.Clientset) {
-	key := "persistent_volume"
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
-	persistentVolumesList.Store(l)
-	return
-}
-
-func getPersistentVolumeSuggestions(ctx context.Context, client *kubernetes.Clientset) []prompt.Suggest {
-	go fetchPersistentVolumeList(ctx, client)
-	l, ok := persistentVolumesList.Load().(*corev1.PersistentVolumeList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Pod Templates */
-
-var (
-	podTemplateList *sync.Map
-)
-
-func fetchPodTemplateList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "pod_template_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().PodTemplates(namespace).List(ctx, metav1.ListOptions{})
-	podTemplateList.Store(namespace, l)
-	return
-}
-
-func getPodTemplateSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchPodTemplateList(ctx, client, namespace)
-	x, ok := podTemplateList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.PodTemplateList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Replica Sets */
-
-var (
-	replicaSetList *sync.Map
-)
-
-func fetchReplicaSetList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "replica_set_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.AppsV1beta2().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
-	replicaSetList.Store(namespace, l)
-	return
-}
-
-func getReplicaSetSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchReplicaSetList(ctx, client, namespace)
-	x, ok := replicaSetList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(appsv1.ReplicaSetList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Replication Controller */
-
-var (
-	replicationControllerList *sync.Map
-)
-
-func fetchReplicationControllerList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "replication_controller" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().ReplicationControllers(namespace).List(ctx, metav1.ListOptions{})
-	replicationControllerList.Store(namespace, l)
-	return
-}
-
-func getReplicationControllerSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchReplicationControllerList(ctx, client, namespace)
-	x, ok := replicationControllerList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.ReplicationControllerList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Resource quotas */
-
-var (
-	resourceQuotaList *sync.Map
-)
-
-func fetchResourceQuotaList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "resource_quota" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().ResourceQuotas(namespace).List(ctx, metav1.ListOptions{})
-	resourceQuotaList.Store(namespace, l)
-	return
-}
-
-func getResourceQuotasSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchResourceQuotaList(ctx, client, namespace)
-	x, ok := resourceQuotaList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.ResourceQuotaList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Service Account */
-
-var (
-	serviceAccountList *sync.Map
-)
-
-func fetchServiceAccountList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "service_account_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
-	serviceAccountList.Store(namespace, l)
-	return
-}
-
-func getServiceAccountSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchServiceAccountList(ctx, client, namespace)
-	x, ok := serviceAccountList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.ServiceAccountList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Service */
-
-var (
-	serviceList *sync.Map
-)
-
-func fetchServiceList(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "service_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
-	serviceList.Store(namespace, l)
-	return
-}
-
-func getServiceSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchServiceList(ctx, client, namespace)
-	x, ok := serviceList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*corev1.ServiceList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text: l.Items[i].Name,
-		}
-	}
-	return s
-}
-
-/* Job */
-
-var (
-	jobList *sync.Map
-)
-
-func fetchJobs(ctx context.Context, client *kubernetes.Clientset, namespace string) {
-	key := "job_" + namespace
-	if !shouldFetch(key) {
-		return
-	}
-	updateLastFetchedAt(key)
-
-	l, _ := client.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
-	jobList.Store(namespace, l)
-}
-
-func getJobSuggestions(ctx context.Context, client *kubernetes.Clientset, namespace string) []prompt.Suggest {
-	go fetchJobs(ctx, client, namespace)
-	x, ok := jobList.Load(namespace)
-	if !ok {
-		return []prompt.Suggest{}
-	}
-	l, ok := x.(*batchv1.JobList)
-	if !ok || len(l.Items) == 0 {
-		return []prompt.Suggest{}
-	}
-	s := make([]prompt.Suggest, len(l.Items))
-	for i := range l.Items {
-		s[i] = prompt.Suggest{
-			Text:        l.Items[i].Name,
-			Description: l.Items[i].Status.StartTime.String(),
-		}
-	}
-	return s
-}
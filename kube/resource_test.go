@@ -0,0 +1,79 @@
+package kube
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLookupResourceDescriptor(t *testing.T) {
+	var scenarioTable = []struct {
+		name       string
+		expected   string
+		namespaced bool
+		found      bool
+	}{
+		{name: "sts", expected: "apps/v1, Resource=statefulsets", namespaced: true, found: true},
+		{name: "cj", expected: "batch/v1, Resource=cronjobs", namespaced: true, found: true},
+		{name: "clusterroles", expected: "rbac.authorization.k8s.io/v1, Resource=clusterroles", found: true},
+		{name: "rolebindings.rbac.authorization.k8s.io", expected: "rbac.authorization.k8s.io/v1, Resource=rolebindings", namespaced: true, found: true},
+		{name: "pdb", expected: "policy/v1, Resource=poddisruptionbudgets", namespaced: true, found: true},
+		{name: "sc", expected: "storage.k8s.io/v1, Resource=storageclasses", found: true},
+		{name: "Lease", expected: "coordination.k8s.io/v1, Resource=leases", namespaced: true, found: true},
+		{name: "csr", expected: "certificates.k8s.io/v1, Resource=certificatesigningrequests", found: true},
+		{name: "validatingwebhookconfigurations", expected: "admissionregistration.k8s.io/v1, Resource=validatingwebhookconfigurations", found: true},
+		{name: "ingresses.extensions", expected: "networking.k8s.io/v1, Resource=ingresses", namespaced: true, found: true},
+		{name: "certificates", found: false},
+	}
+
+	for _, s := range scenarioTable {
+		r, found := lookupResourceDescriptor(s.name)
+		if found != s.found {
+			t.Errorf("%s: should be found=%t, but got %t", s.name, s.found, found)
+			continue
+		}
+		if !found {
+			continue
+		}
+		if r.gvr.String() != s.expected || r.namespaced != s.namespaced {
+			t.Errorf("%s: should be %s (namespaced=%t), but got %s (namespaced=%t)", s.name, s.expected, s.namespaced, r.gvr.String(), r.namespaced)
+		}
+	}
+}
+
+func TestServedGVR(t *testing.T) {
+	client := newTestDiscovery()
+	client.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "extensions/v1beta1",
+			APIResources: []metav1.APIResource{{Name: "ingresses", Kind: "Ingress", Namespaced: true}},
+		},
+		{
+			GroupVersion: "batch/v1beta1",
+			APIResources: []metav1.APIResource{{Name: "cronjobs", Kind: "CronJob", Namespaced: true}},
+		},
+		{
+			GroupVersion: "autoscaling/v1",
+			APIResources: []metav1.APIResource{{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Namespaced: true}},
+		},
+	}
+	fetchAPIResources(client, "test-served")
+	c := &Completer{context: "test-served", client: fake.NewSimpleClientset()}
+
+	var scenarioTable = []struct {
+		resource *resourceDescriptor
+		expected string
+	}{
+		{resource: ingressResource, expected: "extensions/v1beta1, Resource=ingresses"},
+		{resource: cronJobResource, expected: "batch/v1beta1, Resource=cronjobs"},
+		{resource: horizontalPodAutoscalerResource, expected: "autoscaling/v1, Resource=horizontalpodautoscalers"},
+		{resource: statefulSetResource, expected: "apps/v1, Resource=statefulsets"},
+	}
+
+	for _, s := range scenarioTable {
+		if actual := c.servedGVR(s.resource).String(); actual != s.expected {
+			t.Errorf("Should be %s, but got %s", s.expected, actual)
+		}
+	}
+}
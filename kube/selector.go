@@ -0,0 +1,166 @@
+package kube
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/c-bata/go-prompt"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+/* Label selectors */
+
+// selectorResourceTypes are the resource types selected by '-l' of the
+// commands which do not take resource types like 'logs -l app=web'.
+var selectorResourceTypes = map[string]string{
+	"logs":     "pods",
+	"top pod":  "pods",
+	"top node": "nodes",
+}
+
+// selectorResource returns the resource type whose objects the selector of the command selects.
+func selectorResource(line commandLine) (string, bool) {
+	commandArgs, _ := excludeOptions(line.args[:len(line.args)-1])
+	path, _ := lookupCommand(commandArgs)
+	if resourceType, ok := selectorResourceTypes[commandName(path)]; ok {
+		return resourceType, true
+	}
+	resourceType, _, ok := commandResource(line)
+	return resourceType, ok
+}
+
+// labelValues are the values of each label key like {"app": {"web", "db"}}.
+type labelValues map[string][]string
+
+// getLabelValues collects the labels of the cached objects of the resource type.
+func (c *Completer) getLabelValues(resourceType, namespace string) labelValues {
+	seen := make(map[string]map[string]struct{})
+	for _, obj := range c.listObjectsOf(resourceType, namespace) {
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			continue
+		}
+		for k, v := range o.GetLabels() {
+			if seen[k] == nil {
+				seen[k] = make(map[string]struct{})
+			}
+			seen[k][v] = struct{}{}
+		}
+	}
+	l := make(labelValues, len(seen))
+	for k := range seen {
+		values := make([]string, 0, len(seen[k]))
+		for v := range seen[k] {
+			values = append(values, v)
+		}
+		sort.Strings(values)
+		l[k] = values
+	}
+	return l
+}
+
+// selectorClauseStart returns the start of the last requirement of the
+// selector like 'tier' in 'app in (web,api),tier'.
+func selectorClauseStart(value string) int {
+	depth := 0
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				start = i + 1
+			}
+		}
+	}
+	return start
+}
+
+// cutSelectorOperator finds the operator of the requirement like 'app!=web'.
+// i is the start of '=', '==' or '!=', and j is the end of it, which is -1
+// if the operator is incomplete like 'app!'.
+func cutSelectorOperator(clause string) (i, j int, found bool) {
+	i = strings.IndexAny(clause, "=!")
+	if i <= 0 {
+		return 0, 0, false
+	}
+	switch {
+	case strings.HasPrefix(clause[i:], "!="), strings.HasPrefix(clause[i:], "=="):
+		return i, i + 2, true
+	case clause[i] == '=':
+		return i, i + 1, true
+	}
+	return i, -1, true
+}
+
+// labelSelectorSuggestions completes the last requirement of the selector.
+// Keys are suggested first like 'app' or '!app', then values after the
+// operators like 'app=', 'app!=' or 'app in (web,'. Suggestions are the
+// whole selectors.
+func labelSelectorSuggestions(l labelValues, value string) []prompt.Suggest {
+	start := selectorClauseStart(value)
+	clause := value[start:]
+
+	if i := strings.IndexByte(clause, '('); i >= 0 {
+		// 'app in (web,'
+		fields := strings.Fields(clause[:i])
+		if len(fields) == 0 || strings.Contains(clause[i:], ")") {
+			return []prompt.Suggest{}
+		}
+		j := strings.LastIndexByte(clause, ',') + 1
+		if j <= i {
+			j = i + 1
+		}
+		typed := make(map[string]struct{})
+		for _, v := range strings.Split(clause[i+1:j], ",") {
+			typed[strings.TrimSpace(v)] = struct{}{}
+		}
+		suggests := make([]prompt.Suggest, 0, len(l[fields[0]]))
+		for _, v := range l[fields[0]] {
+			if _, ok := typed[v]; ok {
+				continue
+			}
+			suggests = append(suggests, prompt.Suggest{Text: value[:start+j] + v})
+		}
+		return suggests
+	}
+
+	if i, j, found := cutSelectorOperator(clause); found {
+		// 'app=', 'app==' or 'app!='
+		if j < 0 {
+			return []prompt.Suggest{}
+		}
+		key := clause[:i]
+		suggests := make([]prompt.Suggest, len(l[key]))
+		for k, v := range l[key] {
+			suggests[k] = prompt.Suggest{Text: value[:start+j] + v}
+		}
+		return suggests
+	}
+
+	prefix := value[:start]
+	if strings.HasPrefix(clause, "!") {
+		// '!app' selects objects without the label.
+		prefix += "!"
+	}
+	keys := make([]string, 0, len(l))
+	for k := range l {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	suggests := make([]prompt.Suggest, len(keys))
+	for i, k := range keys {
+		suggests[i] = prompt.Suggest{Text: prefix + k, Description: labelValuesDescription(l[k])}
+	}
+	return suggests
+}
+
+func labelValuesDescription(values []string) string {
+	if len(values) > 3 {
+		return strings.Join(values[:3], ", ") + ", ..."
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,45 @@
+package kube
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLabelSelectorSuggestions(t *testing.T) {
+	l := labelValues{
+		"app":  {"api", "db", "web"},
+		"tier": {"backend", "frontend"},
+	}
+
+	var scenarioTable = []struct {
+		value    string
+		expected []string
+	}{
+		{value: "", expected: []string{"app", "tier"}},
+		{value: "!", expected: []string{"!app", "!tier"}},
+		{value: "app=", expected: []string{"app=api", "app=db", "app=web"}},
+		{value: "app!=", expected: []string{"app!=api", "app!=db", "app!=web"}},
+		{value: "app=web,ti", expected: []string{"app=web,tier"}},
+		{value: "app=web,tier==", expected: []string{"app=web,tier==backend", "app=web,tier==frontend"}},
+		{value: "app in (", expected: []string{"app in (api", "app in (db", "app in (web"}},
+		{value: "app in (api,web,", expected: []string{"app in (api,web,db"}},
+		{value: "app in (api,web),", expected: []string{"app in (api,web),app", "app in (api,web),tier"}},
+		{value: "app in (api)", expected: []string{}},
+		{value: "app!", expected: []string{}},
+		{value: "unknown=", expected: []string{}},
+	}
+
+	for _, s := range scenarioTable {
+		actual := labelSelectorSuggestions(l, s.value)
+		texts := make([]string, 0, len(actual))
+		for i := range actual {
+			if strings.HasPrefix(actual[i].Text, s.value) {
+				texts = append(texts, actual[i].Text)
+			}
+		}
+		if !reflect.DeepEqual(texts, s.expected) {
+			t.Errorf("%q: should be %v, but got %v", s.value, s.expected, texts)
+		}
+	}
+}
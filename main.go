@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 
@@ -20,7 +21,20 @@ var (
 )
 
 func main() {
-	c, err := kube.NewCompleter(context.TODO())
+	var opts kube.Options
+	flag.StringVar(&opts.Kubeconfig, "kubeconfig", "", "path to the kubeconfig file")
+	flag.StringVar(&opts.Context, "context", "", "name of the kubeconfig context to use")
+	flag.StringVar(&opts.Namespace, "namespace", "", "namespace to use")
+	flag.BoolVar(&opts.ReadOnly, "read-only", false, "refuse kubectl commands which modify the cluster")
+	flag.Parse()
+
+	config, err := kube.LoadConfig()
+	if err != nil {
+		fmt.Println("error", err)
+		os.Exit(1)
+	}
+
+	c, err := kube.NewCompleter(context.TODO(), opts)
 	if err != nil {
 		fmt.Println("error", err)
 		os.Exit(1)
@@ -29,14 +43,21 @@ func main() {
 	defer debug.Teardown()
 	fmt.Printf("kube-prompt %s (rev-%s)\n", version, revision)
 	fmt.Println("Please use `exit` or `Ctrl-D` to exit this program.")
+	if opts.ReadOnly {
+		fmt.Println("Read-only mode: commands which modify the cluster are refused.")
+	}
 	defer fmt.Println("Bye!")
-	p := prompt.New(
-		kube.Executor,
-		c.Complete,
+	e := kube.NewExecutor(c, config)
+	options := append([]prompt.Option{
 		prompt.OptionTitle("kube-prompt: interactive kubernetes client"),
 		prompt.OptionPrefix(">>> "),
 		prompt.OptionInputTextColor(prompt.Yellow),
 		prompt.OptionCompletionWordSeparator(completer.FilePathCompletionSeparator),
+	}, kube.PrefixOptions(c, config)...)
+	p := prompt.New(
+		e.Execute,
+		c.Complete,
+		options...,
 	)
 	p.Run()
 }